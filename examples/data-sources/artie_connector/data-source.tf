@@ -0,0 +1,10 @@
+# Look up a connector by its UUID
+data "artie_connector" "postgres" {
+  uuid = "00000000-0000-0000-0000-000000000000"
+}
+
+resource "artie_source_reader" "example" {
+  name           = "Production Postgres reader"
+  connector_uuid = data.artie_connector.postgres.uuid
+  database_name  = "customers"
+}
@@ -0,0 +1,7 @@
+data "artie_connector_databases" "postgres" {
+  connector_uuid = artie_connector.postgres.uuid
+}
+
+output "postgres_databases" {
+  value = data.artie_connector_databases.postgres.databases
+}
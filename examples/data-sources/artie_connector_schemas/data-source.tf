@@ -0,0 +1,8 @@
+data "artie_connector_schemas" "orders" {
+  connector_uuid = artie_connector.postgres.uuid
+  database_name  = "orders"
+}
+
+output "orders_schemas" {
+  value = data.artie_connector_schemas.orders.schemas
+}
@@ -0,0 +1,24 @@
+data "artie_connector_tables" "public" {
+  connector_uuid = artie_connector.postgres.uuid
+  database_name  = "orders"
+  schema_name    = "public"
+}
+
+# Replicate every readable table in the schema, skipping views
+resource "artie_pipeline" "postgres_to_snowflake" {
+  name               = "PostgreSQL to Snowflake"
+  source_reader_uuid = artie_source_reader.postgres.uuid
+  tables = {
+    for table in data.artie_connector_tables.public.tables :
+    "${table.schema}.${table.name}" => {
+      name   = table.name
+      schema = table.schema
+    }
+    if !table.is_view && !table.unreadable
+  }
+  destination_connector_uuid = artie_connector.snowflake.uuid
+  destination_config = {
+    database = "ANALYTICS"
+    schema   = "PUBLIC"
+  }
+}
@@ -0,0 +1,20 @@
+# List all running Postgres pipelines whose names start with "prod-"
+data "artie_pipelines" "production" {
+  name_regex  = "^prod-"
+  status      = "running"
+  source_type = "postgresql"
+}
+
+output "production_pipeline_uuids" {
+  value = data.artie_pipelines.production.pipelines[*].uuid
+}
+
+# Warn if any pipeline is stuck deploying
+data "artie_pipelines" "all" {}
+
+check "no_pipelines_stuck_deploying" {
+  assert {
+    condition     = length([for p in data.artie_pipelines.all.pipelines : p if p.is_deploying]) == 0
+    error_message = "Some Artie pipelines are still deploying."
+  }
+}
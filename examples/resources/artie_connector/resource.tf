@@ -101,3 +101,119 @@ resource "artie_connector" "iceberg_rest_catalog_oauth2" {
     scope      = "catalog"
   }
 }
+
+variable "clickhouse_password" {
+  type      = string
+  sensitive = true
+}
+
+resource "artie_connector" "clickhouse_destination" {
+  name = "ClickHouse Destination"
+  type = "clickhouse"
+  clickhouse_config = {
+    host     = "abc123.us-east-1.aws.clickhouse.cloud"
+    port     = 9440
+    username = "artie"
+    password = var.clickhouse_password
+    database = "analytics"
+  }
+}
+
+variable "motherduck_token" {
+  type      = string
+  sensitive = true
+}
+
+resource "artie_connector" "motherduck_destination" {
+  name = "MotherDuck Destination"
+  type = "motherduck"
+  motherduck_config = {
+    token    = var.motherduck_token
+    database = "analytics"
+  }
+}
+
+variable "planetscale_password" {
+  type      = string
+  sensitive = true
+}
+
+resource "artie_connector" "planetscale_source" {
+  name = "PlanetScale Source"
+  type = "planetscale"
+  planetscale_config = {
+    host     = "aws.connect.psdb.cloud"
+    username = "artie"
+    password = var.planetscale_password
+    branch   = "main"
+  }
+}
+
+variable "documentdb_password" {
+  type      = string
+  sensitive = true
+}
+
+resource "artie_connector" "documentdb_source" {
+  name = "DocumentDB Source"
+  type = "documentdb"
+  documentdb_config = {
+    host     = "my-cluster.cluster-abc123.us-east-1.docdb.amazonaws.com"
+    username = "artie"
+    password = var.documentdb_password
+  }
+}
+
+variable "redis_password" {
+  type      = string
+  sensitive = true
+}
+
+resource "artie_connector" "redis_source" {
+  name = "Redis Source"
+  type = "redis"
+  redis_config = {
+    host        = "sessions.abc123.use1.cache.amazonaws.com"
+    password    = var.redis_password
+    tls_enabled = true
+  }
+}
+
+resource "artie_connector" "delta_destination" {
+  name = "Delta Lake Destination"
+  type = "delta"
+  delta_config = {
+    storage_provider = "s3"
+    region           = "us-east-1"
+    role_arn         = "arn:aws:iam::123456789012:role/artie-delta"
+    external_id      = "artie-external-id"
+  }
+}
+
+# With Terraform 1.11 or later, secrets can be passed as write-only attributes so that they're never stored in state.
+# Bump the version whenever the secret changes so that the new value is sent to Artie.
+variable "postgres_prod_password" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
+
+resource "artie_connector" "postgres_prod" {
+  name = "Postgres Prod"
+  type = "postgresql"
+  postgresql_config = {
+    host                = "prod.example.com"
+    port                = 5432
+    username            = "artie"
+    password_wo         = var.postgres_prod_password
+    password_wo_version = 1
+  }
+}
+
+# Get a warning during plan if Artie can no longer connect, e.g. after a credential expires.
+check "postgres_prod_is_valid" {
+  assert {
+    condition     = artie_connector.postgres_prod.is_valid
+    error_message = "Artie can no longer connect to ${artie_connector.postgres_prod.name}."
+  }
+}
@@ -0,0 +1,18 @@
+# Rotate the key every 90 days. The new key is created before the old one is deleted.
+resource "time_rotating" "ingestion_api_key" {
+  rotation_days = 90
+}
+
+resource "artie_ingestion_api_key" "events" {
+  name               = "Events ingestion"
+  source_reader_uuid = artie_source_reader.events.uuid
+  expires_at         = timeadd(time_rotating.ingestion_api_key.rfc3339, "2400h")
+  rotation_triggers = {
+    rotated_at = time_rotating.ingestion_api_key.id
+  }
+}
+
+output "events_ingestion_api_key" {
+  value     = artie_ingestion_api_key.events.secret
+  sensitive = true
+}
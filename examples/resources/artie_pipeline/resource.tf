@@ -22,4 +22,26 @@ resource "artie_pipeline" "postgres_to_snowflake" {
   turbo_warehouse                 = "ARTIE_WEB_WH_LARGE"
   turbo_row_threshold             = 500000
   turbo_latency_threshold_minutes = 30
+
+  # Don't backfill existing rows when the pipeline starts; only replicate new changes.
+  start_behavior = {
+    skip_backfill = true
+  }
+
+  # Keep the pipeline running, and plan to resume it if it's paused in the Artie dashboard.
+  desired_status = "running"
+
+  # Wait for Artie to deploy changes before finishing the apply.
+  wait_for_deployment = true
+  timeouts {
+    create = "20m"
+    update = "20m"
+  }
+}
+
+check "postgres_to_snowflake_is_running" {
+  assert {
+    condition     = artie_pipeline.postgres_to_snowflake.status == "running" && !artie_pipeline.postgres_to_snowflake.has_undeployed_changes
+    error_message = "The PostgreSQL to Snowflake pipeline is ${artie_pipeline.postgres_to_snowflake.status} or has undeployed changes."
+  }
 }
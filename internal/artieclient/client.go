@@ -15,48 +15,19 @@ import (
 	"terraform-provider-artie/internal/openapi"
 )
 
-type HttpError struct {
-	StatusCode int
-	message    string
-}
-
-func (he HttpError) Error() string {
-	message := he.message
-	if len(message) == 0 {
-		message = "server returned a non-200 status code"
-	}
-	return fmt.Sprintf("%s (HTTP %d)", message, he.StatusCode)
-}
-
 type Client struct {
-	endpoint string
-	apiKey   string
-	version  string
+	endpoint   string
+	apiKey     string
+	version    string
+	httpClient *http.Client
 }
 
-func New(endpoint string, apiKey string, version string) (Client, error) {
+func New(endpoint string, apiKey string, version string, retryConfig RetryConfig) (Client, error) {
 	if !strings.HasPrefix(apiKey, "arsk_") {
 		return Client{}, fmt.Errorf("artie-client: api key is malformed (should start with arsk_)")
 	}
 
-	return Client{endpoint: endpoint, apiKey: apiKey, version: version}, nil
-}
-
-func buildError(body []byte, resp *http.Response) error {
-	if resp.StatusCode == http.StatusNotFound {
-		return fmt.Errorf("artie-client: not found, request: %q, method: %q, response: %q", resp.Request.URL.String(), resp.Request.Method, string(body))
-	} else if resp.StatusCode >= 400 && resp.StatusCode < 500 { // Client errors
-		type errorBody struct {
-			ErrorMsg string `json:"error"`
-		}
-
-		var errorResponse errorBody
-		if err := json.Unmarshal(body, &errorResponse); err == nil {
-			return HttpError{StatusCode: resp.StatusCode, message: errorResponse.ErrorMsg}
-		}
-
-	}
-	return HttpError{StatusCode: resp.StatusCode}
+	return Client{endpoint: endpoint, apiKey: apiKey, version: version, httpClient: NewHTTPClient(retryConfig)}, nil
 }
 
 func (c Client) makeRequest(ctx context.Context, method string, path string, body any, out any) error {
@@ -83,7 +54,7 @@ func (c Client) makeRequest(ctx context.Context, method string, path string, bod
 	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.apiKey))
 	req.Header.Set("User-Agent", "terraform-provider-artie/"+c.version)
 
-	resp, err := http.DefaultClient.Do(req)
+	resp, err := c.httpClient.Do(req)
 	if err != nil {
 		return fmt.Errorf("request failed: %w", err)
 	}
@@ -116,22 +87,6 @@ func makeRequest[Out any](ctx context.Context, client Client, method string, pat
 	return *respBody, nil
 }
 
-func BuildResponseError(statusCode int, body []byte) error {
-	if statusCode == http.StatusNotFound {
-		return fmt.Errorf("artie-client: not found (HTTP %d), response: %q", statusCode, string(body))
-	} else if statusCode >= 400 && statusCode < 500 {
-		type errorBody struct {
-			ErrorMsg string `json:"error"`
-		}
-
-		var errorResponse errorBody
-		if err := json.Unmarshal(body, &errorResponse); err == nil && errorResponse.ErrorMsg != "" {
-			return HttpError{StatusCode: statusCode, message: errorResponse.ErrorMsg}
-		}
-	}
-	return HttpError{StatusCode: statusCode}
-}
-
 func (c Client) Connectors() ConnectorClient {
 	return ConnectorClient{client: c}
 }
@@ -63,7 +63,7 @@ func (cc ColumnHashingSaltClient) Update(ctx context.Context, saltUUID string, r
 		return ColumnHashingSalt{}, err
 	}
 
-	return makeRequest[ColumnHashingSalt](ctx, cc.client, http.MethodPost, path, req)
+	return makeRequest[ColumnHashingSalt](retrySafe(ctx), cc.client, http.MethodPost, path, req)
 }
 
 func (cc ColumnHashingSaltClient) Delete(ctx context.Context, saltUUID string) error {
@@ -5,78 +5,85 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"time"
 
 	"github.com/google/uuid"
 )
 
+// ConnectorType is the slug of a connector type, e.g. `postgresql`. The supported types are defined in the
+// provider's connectors package.
+type ConnectorType string
+
 type BaseConnector struct {
-	Type          ConnectorType   `json:"type"`
-	Label         string          `json:"label"`
-	DataPlaneName string          `json:"dataPlaneName"`
-	SSHTunnelUUID *uuid.UUID      `json:"sshTunnelUUID"`
-	Config        ConnectorConfig `json:"sharedConfig"`
+	Type                    ConnectorType   `json:"type"`
+	Label                   string          `json:"label"`
+	DataPlaneName           string          `json:"dataPlaneName"`
+	SSHTunnelUUID           *uuid.UUID      `json:"sshTunnelUUID"`
+	PrivateLinkUUID         *uuid.UUID      `json:"privateLinkUUID"`
+	SnapshotPrivateLinkUUID *uuid.UUID      `json:"snapshotPrivateLinkUUID"`
+	DefaultDatabase         string          `json:"defaultDatabase"`
+	Config                  ConnectorConfig `json:"sharedConfig"`
 }
 type Connector struct {
 	BaseConnector
 	UUID uuid.UUID `json:"uuid"`
 }
 
-type ConnectorConfig struct {
-	Host         string `json:"host"`
-	SnapshotHost string `json:"snapshotHost"`
-	SnapshotPort int32  `json:"snapshotPort,omitempty"`
-	Port         int32  `json:"port"`
-	Endpoint     string `json:"endpoint"`
-	User         string `json:"user"`
-	Username     string `json:"username"`
-	Password     string `json:"password"`
-
-	// MySQL:
-	MySQLTLSMode string `json:"tlsMode,omitempty"`
-
-	// BigQuery:
-	GCPProjectID       string `json:"projectID"`
-	GCPLocation        string `json:"location"`
-	GCPCredentialsData string `json:"credentialsData"`
-
-	// Snowflake:
-	SnowflakeAccountIdentifier string `json:"accountIdentifier"`
-	SnowflakeAccountURL        string `json:"accountURL"`
-	SnowflakeVirtualDWH        string `json:"virtualDWH"`
-	SnowflakePrivateKey        string `json:"privateKey"`
-
-	// Databricks:
-	DatabricksHttpPath            string `json:"httpPath"`
-	DatabricksPersonalAccessToken string `json:"personalAccessToken"`
-	DatabricksClientID            string `json:"clientID"`
-	DatabricksClientSecret        string `json:"clientSecret"`
-	DatabricksVolume              string `json:"volume"`
-
-	// Dynamo, S3, Iceberg, Keyspaces:
-	AWSAccessKeyID     string `json:"awsAccessKeyID"`
-	AWSSecretAccessKey string `json:"awsSecretAccessKey"`
-	AWSRoleARN         string `json:"awsRoleARN"`
-	AWSExternalID      string `json:"awsExternalID"`
-
-	// Dynamo:
-	DynamoStreamArn string `json:"streamsArn"`
-
-	// S3, Keyspaces:
-	AWSRegion string `json:"awsRegion"`
-
-	// Iceberg:
-	IcebergProvider  string `json:"provider"`
-	IcebergBucketARN string `json:"bucketARN"`
-	IcebergRegion    string `json:"region,omitempty"`
-
-	// Iceberg REST Catalog:
-	IcebergURI        string `json:"uri,omitempty"`
-	IcebergToken      string `json:"token,omitempty"`
-	IcebergCredential string `json:"credential,omitempty"`
-	IcebergAuthURI    string `json:"authURI,omitempty"`
-	IcebergScope      string `json:"scope,omitempty"`
-	IcebergWarehouse  string `json:"warehouse,omitempty"`
-	IcebergPrefix     string `json:"prefix,omitempty"`
+// ConnectorDetails is a connector along with the read-only metadata that the API returns for it (see
+// openapi.PayloadsFullConnector).
+type ConnectorDetails struct {
+	Connector
+	EnvironmentUUID uuid.UUID `json:"environmentUUID"`
+	IsValid         bool      `json:"isValid"`
+	CreatedAt       time.Time `json:"createdAt"`
+	UpdatedAt       time.Time `json:"updatedAt"`
+}
+
+// ConnectorConfig holds a connector's settings, which depend on its type. The Terraform models of each type in the
+// provider's connectors package convert to and from it.
+type ConnectorConfig map[string]any
+
+// String returns the string setting named key, or "" if it isn't set.
+func (c ConnectorConfig) String(key string) string {
+	value, _ := c[key].(string)
+	return value
+}
+
+// Int32 returns the integer setting named key, or 0 if it isn't set.
+func (c ConnectorConfig) Int32(key string) int32 {
+	switch value := c[key].(type) {
+	case int32:
+		return value
+	case int:
+		return int32(value)
+	case float64:
+		// Numbers in JSON responses are decoded as float64.
+		return int32(value)
+	}
+	return 0
+}
+
+// Bool returns the boolean setting named key, or false if it isn't set.
+func (c ConnectorConfig) Bool(key string) bool {
+	value, _ := c[key].(bool)
+	return value
+}
+
+// Strings returns the list of strings setting named key, or nil if it isn't set.
+func (c ConnectorConfig) Strings(key string) []string {
+	switch value := c[key].(type) {
+	case []string:
+		return value
+	case []any:
+		var values []string
+		for _, element := range value {
+			if s, ok := element.(string); ok {
+				values = append(values, s)
+			}
+		}
+		return values
+	}
+	return nil
 }
 
 type validationResponse struct {
@@ -91,32 +98,35 @@ func (ConnectorClient) basePath() string {
 	return "connectors"
 }
 
-func (c ConnectorClient) Get(ctx context.Context, connectorUUID string) (Connector, error) {
+func (c ConnectorClient) Get(ctx context.Context, connectorUUID string) (ConnectorDetails, error) {
 	path, err := url.JoinPath(c.basePath(), connectorUUID)
 	if err != nil {
-		return Connector{}, err
+		return ConnectorDetails{}, err
 	}
-	return makeRequest[Connector](ctx, c.client, http.MethodGet, path, nil)
+	return makeRequest[ConnectorDetails](ctx, c.client, http.MethodGet, path, nil)
 }
 
-func (c ConnectorClient) Create(ctx context.Context, connector BaseConnector) (Connector, error) {
+func (c ConnectorClient) Create(ctx context.Context, connector BaseConnector) (ConnectorDetails, error) {
 	body := map[string]any{
-		"type":          connector.Type,
-		"label":         connector.Label,
-		"sharedConfig":  connector.Config,
-		"dataPlaneName": connector.DataPlaneName,
-		"sshTunnelUUID": connector.SSHTunnelUUID,
+		"type":                    connector.Type,
+		"label":                   connector.Label,
+		"sharedConfig":            connector.Config,
+		"dataPlaneName":           connector.DataPlaneName,
+		"sshTunnelUUID":           connector.SSHTunnelUUID,
+		"privateLinkUUID":         connector.PrivateLinkUUID,
+		"snapshotPrivateLinkUUID": connector.SnapshotPrivateLinkUUID,
+		"defaultDatabase":         connector.DefaultDatabase,
 	}
-	return makeRequest[Connector](ctx, c.client, http.MethodPost, c.basePath(), body)
+	return makeRequest[ConnectorDetails](ctx, c.client, http.MethodPost, c.basePath(), body)
 }
 
-func (c ConnectorClient) Update(ctx context.Context, connector Connector) (Connector, error) {
+func (c ConnectorClient) Update(ctx context.Context, connector Connector) (ConnectorDetails, error) {
 	path, err := url.JoinPath(c.basePath(), connector.UUID.String())
 	if err != nil {
-		return Connector{}, err
+		return ConnectorDetails{}, err
 	}
 
-	return makeRequest[Connector](ctx, c.client, http.MethodPost, path, connector)
+	return makeRequest[ConnectorDetails](retrySafe(ctx), c.client, http.MethodPost, path, connector)
 }
 
 func (c ConnectorClient) TestConnection(ctx context.Context, connector BaseConnector) error {
@@ -126,19 +136,21 @@ func (c ConnectorClient) TestConnection(ctx context.Context, connector BaseConne
 	}
 
 	body := map[string]any{
-		"type":          connector.Type,
-		"sharedConfig":  connector.Config,
-		"dataPlaneName": connector.DataPlaneName,
-		"sshTunnelUUID": connector.SSHTunnelUUID,
+		"type":                    connector.Type,
+		"sharedConfig":            connector.Config,
+		"dataPlaneName":           connector.DataPlaneName,
+		"sshTunnelUUID":           connector.SSHTunnelUUID,
+		"privateLinkUUID":         connector.PrivateLinkUUID,
+		"snapshotPrivateLinkUUID": connector.SnapshotPrivateLinkUUID,
 	}
 
-	response, err := makeRequest[validationResponse](ctx, c.client, http.MethodPost, path, body)
+	response, err := makeRequest[validationResponse](retrySafe(ctx), c.client, http.MethodPost, path, body)
 	if err != nil {
 		return err
 	}
 
 	if response.Error != "" {
-		return fmt.Errorf("failed to connect to destination: %s", response.Error)
+		return fmt.Errorf("failed to connect: %s", response.Error)
 	}
 
 	return nil
@@ -0,0 +1,88 @@
+package artieclient
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"terraform-provider-artie/internal/lib"
+	"terraform-provider-artie/internal/openapi"
+)
+
+// ConnectorCatalogClient lists the databases, schemas and tables that a saved connector can see.
+type ConnectorCatalogClient struct {
+	client *openapi.ClientWithResponses
+}
+
+func NewConnectorCatalogClient(client *openapi.ClientWithResponses) ConnectorCatalogClient {
+	return ConnectorCatalogClient{client: client}
+}
+
+func connectorPayload(connectorUUID string) openapi.PayloadsConnectorPayload {
+	return openapi.PayloadsConnectorPayload{Uuid: &connectorUUID}
+}
+
+func (cc ConnectorCatalogClient) Databases(ctx context.Context, connectorUUID string) ([]string, error) {
+	// These endpoints only read from the connector, so they're safe to retry even though they're POSTs.
+	resp, err := cc.client.PostConnectorsDatabasesWithResponse(retrySafe(ctx), connectorPayload(connectorUUID))
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, BuildResponseError(resp.HTTPResponse, resp.Body)
+	}
+
+	databases := []string{}
+	for _, item := range lib.RemovePtr(resp.JSON200.Items) {
+		databases = append(databases, lib.RemovePtr(item.Name))
+	}
+	return databases, nil
+}
+
+func (cc ConnectorCatalogClient) Schemas(ctx context.Context, connectorUUID string, databaseName string) ([]string, error) {
+	resp, err := cc.client.PostConnectorsSchemasWithResponse(retrySafe(ctx), openapi.RouterConnectorFetchSchemasRequest{
+		Connector:    connectorPayload(connectorUUID),
+		DatabaseName: databaseName,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode() >= 300 {
+		return nil, BuildResponseError(resp.HTTPResponse, resp.Body)
+	}
+
+	// The spec doesn't declare this endpoint's response body, so it isn't decoded by the generated client. It has the
+	// same shape as the databases endpoint's.
+	var body openapi.PayloadsConnectorFetchDatabasesResponse
+	if err := json.Unmarshal(resp.Body, &body); err != nil {
+		return nil, fmt.Errorf("artie-client: failed to decode response body: %w", err)
+	}
+
+	schemas := []string{}
+	for _, item := range lib.RemovePtr(body.Items) {
+		schemas = append(schemas, lib.RemovePtr(item.Name))
+	}
+	return schemas, nil
+}
+
+// Tables lists the tables in a database, optionally only those in schemaName. Artie caches the list of tables, so
+// forceRefresh can be used to read it from the database again.
+func (cc ConnectorCatalogClient) Tables(ctx context.Context, connectorUUID string, databaseName string, schemaName string, forceRefresh bool) ([]openapi.RouterConnectorTable, error) {
+	req := openapi.RouterConnectorFetchTablesRequest{
+		Connector:    connectorPayload(connectorUUID),
+		DatabaseName: databaseName,
+		ForceRefresh: &forceRefresh,
+	}
+	if schemaName != "" {
+		req.SchemaName = &schemaName
+	}
+
+	resp, err := cc.client.PostConnectorsTablesWithResponse(retrySafe(ctx), req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, BuildResponseError(resp.HTTPResponse, resp.Body)
+	}
+	return resp.JSON200.Items, nil
+}
@@ -0,0 +1,34 @@
+package artieclient
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConnectorConfig(t *testing.T) {
+	{
+		config := ConnectorConfig{"host": "db.example.com", "port": int32(5432), "tlsEnabled": true, "shards": []string{"-80", "80-"}}
+		assert.Equal(t, "db.example.com", config.String("host"))
+		assert.Equal(t, int32(5432), config.Int32("port"))
+		assert.True(t, config.Bool("tlsEnabled"))
+		assert.Equal(t, []string{"-80", "80-"}, config.Strings("shards"))
+	}
+	{
+		// Settings decoded from a response have JSON types.
+		var config ConnectorConfig
+		require.NoError(t, json.Unmarshal([]byte(`{"host": "db.example.com", "port": 5432, "shards": ["-80", "80-"]}`), &config))
+		assert.Equal(t, int32(5432), config.Int32("port"))
+		assert.Equal(t, []string{"-80", "80-"}, config.Strings("shards"))
+	}
+	{
+		// Missing settings have their type's zero value.
+		config := ConnectorConfig{}
+		assert.Empty(t, config.String("host"))
+		assert.Zero(t, config.Int32("port"))
+		assert.False(t, config.Bool("tlsEnabled"))
+		assert.Nil(t, config.Strings("shards"))
+	}
+}
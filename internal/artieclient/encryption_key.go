@@ -67,7 +67,7 @@ func (ec EncryptionKeyClient) Update(ctx context.Context, encryptionKeyUUID stri
 		return EncryptionKey{}, err
 	}
 
-	return makeRequest[EncryptionKey](ctx, ec.client, http.MethodPost, path, req)
+	return makeRequest[EncryptionKey](retrySafe(ctx), ec.client, http.MethodPost, path, req)
 }
 
 func (ec EncryptionKeyClient) Delete(ctx context.Context, encryptionKeyUUID string) error {
@@ -0,0 +1,97 @@
+package artieclient
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// HttpError is returned when the Artie API responds with an unsuccessful status code that doesn't map to one of the
+// more specific error types below. Those types all embed HttpError, so the request details are always available.
+type HttpError struct {
+	Method     string
+	URL        string
+	StatusCode int
+	// Message is the error message from the response body, if the server sent one.
+	Message string
+	// Body is the raw response body.
+	Body string
+}
+
+func (he HttpError) Error() string {
+	message := he.Message
+	if len(message) == 0 {
+		message = "server returned a non-200 status code"
+	}
+	return fmt.Sprintf("%s (HTTP %d)", message, he.StatusCode)
+}
+
+// NotFoundError is returned when the requested object doesn't exist (HTTP 404).
+type NotFoundError struct{ HttpError }
+
+func (e NotFoundError) Error() string {
+	return fmt.Sprintf("artie-client: not found (HTTP %d), request: %q, method: %q, response: %q", e.StatusCode, e.URL, e.Method, e.Body)
+}
+
+func (e NotFoundError) Unwrap() error { return e.HttpError }
+
+// ValidationError is returned when the request was rejected because it was invalid (HTTP 400 or 422).
+type ValidationError struct{ HttpError }
+
+func (e ValidationError) Unwrap() error { return e.HttpError }
+
+// ConflictError is returned when the request conflicts with the current state of an object (HTTP 409).
+type ConflictError struct{ HttpError }
+
+func (e ConflictError) Unwrap() error { return e.HttpError }
+
+// UnauthorizedError is returned when the API key is invalid or lacks access to the object (HTTP 401 or 403).
+type UnauthorizedError struct{ HttpError }
+
+func (e UnauthorizedError) Unwrap() error { return e.HttpError }
+
+// RateLimitedError is returned when the request was still rate limited after all retries were used up (HTTP 429).
+type RateLimitedError struct {
+	HttpError
+	// RetryAfter is how long the server asked us to wait before trying again, or zero if it didn't say.
+	RetryAfter time.Duration
+}
+
+func (e RateLimitedError) Unwrap() error { return e.HttpError }
+
+// BuildResponseError converts an unsuccessful response from the generated OpenAPI client into an error.
+func BuildResponseError(resp *http.Response, body []byte) error {
+	return buildError(body, resp)
+}
+
+func buildError(body []byte, resp *http.Response) error {
+	he := HttpError{StatusCode: resp.StatusCode, Body: string(body)}
+	if resp.Request != nil {
+		he.Method = resp.Request.Method
+		he.URL = resp.Request.URL.String()
+	}
+
+	type errorBody struct {
+		ErrorMsg string `json:"error"`
+	}
+	var errorResponse errorBody
+	if err := json.Unmarshal(body, &errorResponse); err == nil {
+		he.Message = errorResponse.ErrorMsg
+	}
+
+	switch resp.StatusCode {
+	case http.StatusNotFound:
+		return NotFoundError{he}
+	case http.StatusBadRequest, http.StatusUnprocessableEntity:
+		return ValidationError{he}
+	case http.StatusConflict:
+		return ConflictError{he}
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return UnauthorizedError{he}
+	case http.StatusTooManyRequests:
+		retryAfter, _ := retryAfter(resp.Header.Get("Retry-After"), time.Now())
+		return RateLimitedError{HttpError: he, RetryAfter: retryAfter}
+	}
+	return he
+}
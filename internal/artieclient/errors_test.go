@@ -0,0 +1,99 @@
+package artieclient
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newErrorTestResponse(t *testing.T, statusCode int) *http.Response {
+	req, err := http.NewRequest(http.MethodGet, "https://api.artie.com/connectors/abc", nil)
+	require.NoError(t, err)
+	return &http.Response{StatusCode: statusCode, Request: req, Header: http.Header{}}
+}
+
+func TestBuildError(t *testing.T) {
+	{
+		// not found
+		err := buildError([]byte(`{"error": "connector not found"}`), newErrorTestResponse(t, http.StatusNotFound))
+		var notFoundErr NotFoundError
+		require.True(t, errors.As(err, &notFoundErr))
+		assert.Equal(t, http.MethodGet, notFoundErr.Method)
+		assert.Equal(t, "https://api.artie.com/connectors/abc", notFoundErr.URL)
+		assert.Equal(t, http.StatusNotFound, notFoundErr.StatusCode)
+		assert.Equal(t, "connector not found", notFoundErr.Message)
+		assert.Equal(t, `{"error": "connector not found"}`, notFoundErr.Body)
+		assert.Contains(t, err.Error(), "not found (HTTP 404)")
+
+		// the specific error types unwrap to HttpError
+		var httpErr HttpError
+		require.True(t, errors.As(err, &httpErr))
+		assert.Equal(t, http.StatusNotFound, httpErr.StatusCode)
+	}
+	{
+		// validation
+		for _, statusCode := range []int{http.StatusBadRequest, http.StatusUnprocessableEntity} {
+			err := buildError([]byte(`{"error": "name is required"}`), newErrorTestResponse(t, statusCode))
+			var validationErr ValidationError
+			require.True(t, errors.As(err, &validationErr))
+			assert.Equal(t, "name is required", validationErr.Message)
+			assert.Equal(t, statusCode, validationErr.StatusCode)
+		}
+	}
+	{
+		// conflict
+		err := buildError([]byte(`{"error": "already exists"}`), newErrorTestResponse(t, http.StatusConflict))
+		assert.True(t, errors.As(err, &ConflictError{}))
+		assert.Equal(t, "already exists (HTTP 409)", err.Error())
+	}
+	{
+		// unauthorized
+		for _, statusCode := range []int{http.StatusUnauthorized, http.StatusForbidden} {
+			err := buildError([]byte(`unauthorized`), newErrorTestResponse(t, statusCode))
+			var unauthorizedErr UnauthorizedError
+			require.True(t, errors.As(err, &unauthorizedErr))
+			assert.Equal(t, statusCode, unauthorizedErr.StatusCode)
+			assert.Equal(t, "unauthorized", unauthorizedErr.Body)
+			assert.Empty(t, unauthorizedErr.Message)
+		}
+	}
+	{
+		// rate limited
+		resp := newErrorTestResponse(t, http.StatusTooManyRequests)
+		resp.Header.Set("Retry-After", "12")
+		err := buildError(nil, resp)
+		var rateLimitedErr RateLimitedError
+		require.True(t, errors.As(err, &rateLimitedErr))
+		assert.Equal(t, 12*time.Second, rateLimitedErr.RetryAfter)
+	}
+	{
+		// anything else is a plain HttpError
+		err := buildError([]byte(`oops`), newErrorTestResponse(t, http.StatusInternalServerError))
+		assert.False(t, errors.As(err, &NotFoundError{}))
+		var httpErr HttpError
+		require.True(t, errors.As(err, &httpErr))
+		assert.Equal(t, http.StatusInternalServerError, httpErr.StatusCode)
+		assert.Equal(t, "server returned a non-200 status code (HTTP 500)", err.Error())
+	}
+}
+
+func TestMakeRequestErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"error": "pipeline not found"}`))
+	}))
+	defer server.Close()
+
+	client := newRetryTestClient(t, server.URL, 0)
+	_, err := client.Pipelines(nil).Get(t.Context(), "3a6c3a8a-2f53-4d1b-8c3b-0e7d3f1c9a11")
+	var notFoundErr NotFoundError
+	require.True(t, errors.As(err, &notFoundErr))
+	assert.Equal(t, http.MethodGet, notFoundErr.Method)
+	assert.Equal(t, server.URL+"/pipelines/3a6c3a8a-2f53-4d1b-8c3b-0e7d3f1c9a11", notFoundErr.URL)
+	assert.Equal(t, "pipeline not found", notFoundErr.Message)
+}
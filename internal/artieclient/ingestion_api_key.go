@@ -0,0 +1,49 @@
+package artieclient
+
+import (
+	"context"
+
+	"terraform-provider-artie/internal/openapi"
+)
+
+type IngestionAPIKeyClient struct {
+	client *openapi.ClientWithResponses
+}
+
+func NewIngestionAPIKeyClient(client *openapi.ClientWithResponses) IngestionAPIKeyClient {
+	return IngestionAPIKeyClient{client: client}
+}
+
+// Create creates an ingestion API key. The response is the only place that the key's secret is ever returned.
+func (ic IngestionAPIKeyClient) Create(ctx context.Context, req openapi.RouterCreateIngestionAPIKeyRequest) (*openapi.RouterCreateIngestionAPIKeyResponse, error) {
+	resp, err := ic.client.PostIngestionApiKeysWithResponse(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, BuildResponseError(resp.HTTPResponse, resp.Body)
+	}
+	return resp.JSON200, nil
+}
+
+func (ic IngestionAPIKeyClient) Update(ctx context.Context, ingestionAPIKeyUUID string, req openapi.RouterUpdateIngestionAPIKeyRequest) (*openapi.PayloadsIngestionAPIKey, error) {
+	resp, err := ic.client.PostIngestionApiKeysUuidWithResponse(retrySafe(ctx), ingestionAPIKeyUUID, req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, BuildResponseError(resp.HTTPResponse, resp.Body)
+	}
+	return resp.JSON200, nil
+}
+
+func (ic IngestionAPIKeyClient) Delete(ctx context.Context, ingestionAPIKeyUUID string) error {
+	resp, err := ic.client.DeleteIngestionApiKeysUuidWithResponse(ctx, ingestionAPIKeyUUID)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode() >= 300 {
+		return BuildResponseError(resp.HTTPResponse, resp.Body)
+	}
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -64,6 +65,19 @@ type Pipeline struct {
 	UUID uuid.UUID `json:"uuid"`
 }
 
+// PipelineDetails is a pipeline along with the runtime state that the API returns for it (see
+// openapi.PayloadsFullPipeline).
+type PipelineDetails struct {
+	Pipeline
+	SourceType           ConnectorType               `json:"sourceType"`
+	Status               openapi.EnumsPipelineStatus `json:"status"`
+	IsDeploying          bool                        `json:"isDeploying"`
+	HasUndeployedChanges bool                        `json:"hasUndeployedChanges"`
+	HasBackfillingTables bool                        `json:"hasBackfillingTables"`
+	LastDeployedAt       *time.Time                  `json:"lastDeployedAt"`
+	LastUpdatedAt        time.Time                   `json:"lastUpdatedAt"`
+}
+
 type Table struct {
 	UUID               uuid.UUID             `json:"uuid"`
 	Name               string                `json:"name"`
@@ -71,6 +85,10 @@ type Table struct {
 	EnableHistoryMode  bool                  `json:"enableHistoryMode"`
 	DisableReplication bool                  `json:"disableReplication"`
 	AdvancedSettings   AdvancedTableSettings `json:"advancedSettings"`
+
+	// Status and HistoryTableStatus are set by Artie, and are ignored when creating or updating a pipeline.
+	Status             *string `json:"status,omitempty"`
+	HistoryTableStatus *string `json:"historyTableStatus,omitempty"`
 }
 
 type MergePredicate struct {
@@ -117,6 +135,7 @@ type AdvancedTableSettings struct {
 	RangeSettings              *RangeSettings    `json:"rangeSettings,omitempty"`
 	SkipBackfill               *bool             `json:"skipBackfill"`
 	SkipNoOpUpdates            *bool             `json:"skipNoOpUpdates"`
+	RedisKeyPattern            *string           `json:"keyPattern,omitempty"`
 }
 
 type FlushConfig struct {
@@ -146,13 +165,24 @@ func (PipelineClient) basePath() string {
 	return "pipelines"
 }
 
-func (pc PipelineClient) Get(ctx context.Context, pipelineUUID string) (Pipeline, error) {
+func (pc PipelineClient) Get(ctx context.Context, pipelineUUID string) (PipelineDetails, error) {
 	path, err := url.JoinPath(pc.basePath(), pipelineUUID)
 	if err != nil {
-		return Pipeline{}, err
+		return PipelineDetails{}, err
 	}
 
-	return makeRequest[Pipeline](ctx, pc.client, http.MethodGet, path, nil)
+	return makeRequest[PipelineDetails](ctx, pc.client, http.MethodGet, path, nil)
+}
+
+func (pc PipelineClient) List(ctx context.Context) ([]openapi.PayloadsLightPipeline, error) {
+	resp, err := pc.openAPICient.GetPipelinesWithResponse(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, BuildResponseError(resp.HTTPResponse, resp.Body)
+	}
+	return resp.JSON200.Items, nil
 }
 
 func (pc PipelineClient) ValidateSource(ctx context.Context, pipeline BasePipeline) error {
@@ -167,7 +197,7 @@ func (pc PipelineClient) ValidateSource(ctx context.Context, pipeline BasePipeli
 		return err
 	}
 
-	response, err := makeRequest[validationResponse](ctx, pc.client, http.MethodPost, path, body)
+	response, err := makeRequest[validationResponse](retrySafe(ctx), pc.client, http.MethodPost, path, body)
 	if err != nil {
 		return err
 	}
@@ -193,7 +223,7 @@ func (pc PipelineClient) ValidateDestination(ctx context.Context, pipeline BaseP
 		return err
 	}
 
-	response, err := makeRequest[validationResponse](ctx, pc.client, http.MethodPost, path, body)
+	response, err := makeRequest[validationResponse](retrySafe(ctx), pc.client, http.MethodPost, path, body)
 	if err != nil {
 		return err
 	}
@@ -205,25 +235,25 @@ func (pc PipelineClient) ValidateDestination(ctx context.Context, pipeline BaseP
 	return err
 }
 
-func (pc PipelineClient) Create(ctx context.Context, pipeline BasePipeline) (Pipeline, error) {
+func (pc PipelineClient) Create(ctx context.Context, pipeline BasePipeline) (PipelineDetails, error) {
 	body := map[string]any{
 		"pipeline": pipeline,
 	}
 
-	return makeRequest[Pipeline](ctx, pc.client, http.MethodPost, pc.basePath(), body)
+	return makeRequest[PipelineDetails](ctx, pc.client, http.MethodPost, pc.basePath(), body)
 }
 
-func (pc PipelineClient) Update(ctx context.Context, pipeline Pipeline) (Pipeline, error) {
+func (pc PipelineClient) Update(ctx context.Context, pipeline Pipeline) (PipelineDetails, error) {
 	path, err := url.JoinPath(pc.basePath(), pipeline.UUID.String())
 	if err != nil {
-		return Pipeline{}, err
+		return PipelineDetails{}, err
 	}
 
 	body := map[string]any{
 		"pipeline": pipeline,
 	}
 
-	return makeRequest[Pipeline](ctx, pc.client, http.MethodPost, path, body)
+	return makeRequest[PipelineDetails](retrySafe(ctx), pc.client, http.MethodPost, path, body)
 }
 
 func (pc PipelineClient) Delete(ctx context.Context, pipelineUUID string) error {
@@ -236,25 +266,26 @@ func (pc PipelineClient) Delete(ctx context.Context, pipelineUUID string) error
 	return err
 }
 
-func (pc PipelineClient) StartPipeline(ctx context.Context, pipelineUUID string) error {
+// StartPipeline deploys a pipeline and starts it, unless options.KeepPaused is set.
+func (pc PipelineClient) StartPipeline(ctx context.Context, pipelineUUID string, options openapi.RouterPipelineStartRequest) error {
 	path, err := url.JoinPath(pc.basePath(), pipelineUUID, "start")
 	if err != nil {
 		return err
 	}
 
-	_, err = makeRequest[any](ctx, pc.client, http.MethodPost, path, nil)
+	_, err = makeRequest[any](retrySafe(ctx), pc.client, http.MethodPost, path, options)
 	return err
 }
 
 func (pc PipelineClient) UpdateStatus(ctx context.Context, pipelineUUID string, status string) error {
-	resp, err := pc.openAPICient.PostPipelinesUuidStatusWithResponse(ctx, pipelineUUID, openapi.RouterPipelineUpdateStatusRequest{
+	resp, err := pc.openAPICient.PostPipelinesUuidStatusWithResponse(retrySafe(ctx), pipelineUUID, openapi.RouterPipelineUpdateStatusRequest{
 		Status: openapi.EnumsPipelineStatus(status),
 	})
 	if err != nil {
 		return err
 	}
 	if resp.StatusCode() >= 300 {
-		return BuildResponseError(resp.StatusCode(), resp.Body)
+		return BuildResponseError(resp.HTTPResponse, resp.Body)
 	}
 	return nil
 }
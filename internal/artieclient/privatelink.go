@@ -51,7 +51,7 @@ func (pc PrivateLinkClient) Update(ctx context.Context, conn PrivateLinkConnecti
 		return PrivateLinkConnection{}, err
 	}
 
-	return makeRequest[PrivateLinkConnection](ctx, pc.client, http.MethodPost, path, conn)
+	return makeRequest[PrivateLinkConnection](retrySafe(ctx), pc.client, http.MethodPost, path, conn)
 }
 
 func (pc PrivateLinkClient) Delete(ctx context.Context, plUUID string) error {
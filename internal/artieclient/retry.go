@@ -0,0 +1,155 @@
+package artieclient
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	DefaultMaxRetries   = 4
+	DefaultRetryMaxWait = 30 * time.Second
+
+	retryBaseWait = 500 * time.Millisecond
+)
+
+type RetryConfig struct {
+	// MaxRetries is the number of times a request will be retried after the initial attempt.
+	MaxRetries int
+	// MaxWait caps the delay between two attempts, including delays requested by a Retry-After header.
+	MaxWait time.Duration
+}
+
+func DefaultRetryConfig() RetryConfig {
+	return RetryConfig{MaxRetries: DefaultMaxRetries, MaxWait: DefaultRetryMaxWait}
+}
+
+type retrySafeKey struct{}
+
+// retrySafe marks requests made with the returned context as safe to repeat, so that they can be retried after a
+// server error even though their method (usually POST) is not idempotent. Requests that create new objects must not
+// be marked, otherwise a retry after a timeout could create a duplicate.
+func retrySafe(ctx context.Context) context.Context {
+	return context.WithValue(ctx, retrySafeKey{}, true)
+}
+
+func isRetrySafe(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	safe, _ := req.Context().Value(retrySafeKey{}).(bool)
+	return safe
+}
+
+// retryTransport is an [http.RoundTripper] that retries requests that were rate limited or failed with a server
+// error, using exponential backoff with full jitter. It's shared by [Client] and the generated OpenAPI client.
+type retryTransport struct {
+	next   http.RoundTripper
+	config RetryConfig
+}
+
+// NewHTTPClient returns an [http.Client] that retries rate-limited and failed requests according to config.
+func NewHTTPClient(config RetryConfig) *http.Client {
+	return &http.Client{Transport: retryTransport{next: http.DefaultTransport, config: config}}
+}
+
+func (t retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	for attempt := 0; ; attempt++ {
+		// RoundTrippers mustn't modify the request, so each attempt sends a clone of it, with a fresh body for retries.
+		attemptReq := req.Clone(ctx)
+		if attempt > 0 && req.Body != nil {
+			if req.GetBody == nil {
+				return nil, fmt.Errorf("artie-client: unable to retry request because its body cannot be rewound")
+			}
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, fmt.Errorf("artie-client: failed to rewind request body: %w", err)
+			}
+			attemptReq.Body = body
+		}
+
+		resp, err := t.next.RoundTrip(attemptReq)
+		if attempt >= t.config.MaxRetries || !shouldRetry(req, resp, err) {
+			return resp, err
+		}
+
+		wait := t.backoff(attempt, resp, time.Now())
+		if err != nil {
+			tflog.Warn(ctx, fmt.Sprintf("Request %s %s failed, retrying in %s: %v", req.Method, req.URL, wait, err))
+		} else {
+			tflog.Warn(ctx, fmt.Sprintf("Request %s %s returned HTTP %d, retrying in %s", req.Method, req.URL, resp.StatusCode, wait))
+			// Drain the body so that the underlying connection can be reused.
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func shouldRetry(req *http.Request, resp *http.Response, err error) bool {
+	if err != nil {
+		return req.Context().Err() == nil && isRetrySafe(req)
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusTooManyRequests:
+		// The request was rejected before it was processed, so it's always safe to send it again.
+		return true
+	case resp.StatusCode == http.StatusNotImplemented:
+		return false
+	case resp.StatusCode >= 500:
+		return isRetrySafe(req)
+	}
+	return false
+}
+
+// backoff returns how long to wait before the next attempt. A Retry-After header takes precedence over the
+// exponential schedule; either way the result is capped at MaxWait.
+func (t retryTransport) backoff(attempt int, resp *http.Response, now time.Time) time.Duration {
+	if resp != nil {
+		if wait, ok := retryAfter(resp.Header.Get("Retry-After"), now); ok {
+			return min(wait, t.config.MaxWait)
+		}
+	}
+
+	ceiling := min(retryBaseWait<<attempt, t.config.MaxWait)
+	if ceiling <= 0 {
+		return 0
+	}
+	return rand.N(ceiling) + 1
+}
+
+// retryAfter parses a Retry-After header value, which is either a number of seconds or an HTTP date.
+func retryAfter(value string, now time.Time) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		return max(date.Sub(now), 0), true
+	}
+
+	return 0, false
+}
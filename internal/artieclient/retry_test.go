@@ -0,0 +1,164 @@
+package artieclient
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newRetryTestServer(t *testing.T, statuses ...int) (*httptest.Server, *atomic.Int32) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		call := int(calls.Add(1))
+		status := statuses[min(call, len(statuses))-1]
+		if status == http.StatusTooManyRequests {
+			w.Header().Set("Retry-After", "0")
+		}
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	t.Cleanup(server.Close)
+	return server, &calls
+}
+
+func newRetryTestClient(t *testing.T, endpoint string, maxRetries int) Client {
+	client, err := New(endpoint, "arsk_test", "test", RetryConfig{MaxRetries: maxRetries, MaxWait: time.Millisecond})
+	require.NoError(t, err)
+	return client
+}
+
+func TestRetryTransport(t *testing.T) {
+	{
+		// GET requests are retried after server errors
+		server, calls := newRetryTestServer(t, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusOK)
+		_, err := makeRequest[any](t.Context(), newRetryTestClient(t, server.URL, 3), http.MethodGet, "pipelines", nil)
+		assert.NoError(t, err)
+		assert.Equal(t, int32(3), calls.Load())
+	}
+	{
+		// retries stop after MaxRetries
+		server, calls := newRetryTestServer(t, http.StatusInternalServerError)
+		_, err := makeRequest[any](t.Context(), newRetryTestClient(t, server.URL, 2), http.MethodGet, "pipelines", nil)
+		assert.ErrorContains(t, err, "HTTP 500")
+		assert.Equal(t, int32(3), calls.Load())
+	}
+	{
+		// POST requests that aren't marked as safe are not retried after server errors
+		server, calls := newRetryTestServer(t, http.StatusInternalServerError, http.StatusOK)
+		_, err := makeRequest[any](t.Context(), newRetryTestClient(t, server.URL, 3), http.MethodPost, "pipelines", map[string]any{"name": "test"})
+		assert.ErrorContains(t, err, "HTTP 500")
+		assert.Equal(t, int32(1), calls.Load())
+	}
+	{
+		// POST requests that are marked as safe are retried, and their body is sent again
+		var bodies []string
+		var calls atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			bodies = append(bodies, string(body))
+			if calls.Add(1) == 1 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			_, _ = w.Write([]byte(`{}`))
+		}))
+		defer server.Close()
+
+		_, err := makeRequest[any](retrySafe(t.Context()), newRetryTestClient(t, server.URL, 3), http.MethodPost, "pipelines/abc", map[string]any{"name": "test"})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"{\"name\":\"test\"}\n", "{\"name\":\"test\"}\n"}, bodies)
+	}
+	{
+		// rate-limited requests are always retried
+		server, calls := newRetryTestServer(t, http.StatusTooManyRequests, http.StatusTooManyRequests, http.StatusOK)
+		_, err := makeRequest[any](t.Context(), newRetryTestClient(t, server.URL, 3), http.MethodPost, "pipelines", map[string]any{"name": "test"})
+		assert.NoError(t, err)
+		assert.Equal(t, int32(3), calls.Load())
+	}
+	{
+		// client errors are never retried
+		server, calls := newRetryTestServer(t, http.StatusBadRequest, http.StatusOK)
+		_, err := makeRequest[any](t.Context(), newRetryTestClient(t, server.URL, 3), http.MethodGet, "pipelines", nil)
+		assert.Error(t, err)
+		assert.Equal(t, int32(1), calls.Load())
+	}
+	{
+		// retries are disabled
+		server, calls := newRetryTestServer(t, http.StatusServiceUnavailable, http.StatusOK)
+		_, err := makeRequest[any](t.Context(), newRetryTestClient(t, server.URL, 0), http.MethodGet, "pipelines", nil)
+		assert.Error(t, err)
+		assert.Equal(t, int32(1), calls.Load())
+	}
+}
+
+func TestRetryTransportDoesNotModifyRequest(t *testing.T) {
+	server, calls := newRetryTestServer(t, http.StatusServiceUnavailable, http.StatusOK)
+	transport := retryTransport{next: http.DefaultTransport, config: RetryConfig{MaxRetries: 3, MaxWait: time.Millisecond}}
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodPut, server.URL, strings.NewReader(`{"name":"test"}`))
+	require.NoError(t, err)
+	body := req.Body
+	resp, err := transport.RoundTrip(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, int32(2), calls.Load())
+	// The retry sent a new body on a clone of the request.
+	assert.True(t, req.Body == body)
+}
+
+func TestRetryTransportBackoff(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	transport := retryTransport{config: RetryConfig{MaxRetries: 5, MaxWait: 10 * time.Second}}
+	resp := func(retryAfter string) *http.Response {
+		header := http.Header{}
+		if retryAfter != "" {
+			header.Set("Retry-After", retryAfter)
+		}
+		return &http.Response{StatusCode: http.StatusTooManyRequests, Header: header}
+	}
+
+	assert.Equal(t, 3*time.Second, transport.backoff(0, resp("3"), now))
+	assert.Equal(t, 5*time.Second, transport.backoff(0, resp(now.Add(5*time.Second).Format(http.TimeFormat)), now))
+	// Retry-After is capped at MaxWait
+	assert.Equal(t, 10*time.Second, transport.backoff(0, resp("120"), now))
+
+	for attempt := range 6 {
+		wait := transport.backoff(attempt, resp(""), now)
+		assert.Greater(t, wait, time.Duration(0))
+		assert.LessOrEqual(t, wait, min(retryBaseWait<<attempt, 10*time.Second))
+	}
+	// an unparseable Retry-After falls back to exponential backoff
+	assert.LessOrEqual(t, transport.backoff(0, resp("soon"), now), retryBaseWait)
+}
+
+func TestRetryAfter(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	{
+		wait, ok := retryAfter("", now)
+		assert.False(t, ok)
+		assert.Zero(t, wait)
+	}
+	{
+		wait, ok := retryAfter("7", now)
+		assert.True(t, ok)
+		assert.Equal(t, 7*time.Second, wait)
+	}
+	{
+		_, ok := retryAfter("-1", now)
+		assert.False(t, ok)
+	}
+	{
+		// dates in the past mean we can retry right away
+		wait, ok := retryAfter(now.Add(-time.Minute).Format(http.TimeFormat), now)
+		assert.True(t, ok)
+		assert.Zero(t, wait)
+	}
+}
@@ -21,13 +21,13 @@ func (sc SourceReaderClient) Get(ctx context.Context, sourceReaderUUID string) (
 		return nil, err
 	}
 	if resp.JSON200 == nil {
-		return nil, BuildResponseError(resp.StatusCode(), resp.Body)
+		return nil, BuildResponseError(resp.HTTPResponse, resp.Body)
 	}
 	return resp.JSON200, nil
 }
 
 func (sc SourceReaderClient) Validate(ctx context.Context, sourceReader openapi.PayloadsSourceReader) error {
-	resp, err := sc.client.PostSourceReadersValidateUnsavedWithResponse(ctx, openapi.RouterSourceReaderValidateUnsavedRequest{
+	resp, err := sc.client.PostSourceReadersValidateUnsavedWithResponse(retrySafe(ctx), openapi.RouterSourceReaderValidateUnsavedRequest{
 		SourceReader: sourceReader,
 	})
 	if err != nil {
@@ -42,7 +42,7 @@ func (sc SourceReaderClient) Validate(ctx context.Context, sourceReader openapi.
 	if resp.StatusCode() >= 200 && resp.StatusCode() < 300 {
 		return nil
 	}
-	return BuildResponseError(resp.StatusCode(), resp.Body)
+	return BuildResponseError(resp.HTTPResponse, resp.Body)
 }
 
 func (sc SourceReaderClient) Create(ctx context.Context, req openapi.RouterSourceReaderCreateRequest) (*openapi.PayloadsSourceReader, error) {
@@ -51,18 +51,18 @@ func (sc SourceReaderClient) Create(ctx context.Context, req openapi.RouterSourc
 		return nil, err
 	}
 	if resp.JSON200 == nil {
-		return nil, BuildResponseError(resp.StatusCode(), resp.Body)
+		return nil, BuildResponseError(resp.HTTPResponse, resp.Body)
 	}
 	return resp.JSON200, nil
 }
 
 func (sc SourceReaderClient) Update(ctx context.Context, uuid string, sourceReader openapi.PayloadsSourceReader) (*openapi.PayloadsSourceReader, error) {
-	resp, err := sc.client.PostSourceReadersUuidWithResponse(ctx, uuid, sourceReader)
+	resp, err := sc.client.PostSourceReadersUuidWithResponse(retrySafe(ctx), uuid, sourceReader)
 	if err != nil {
 		return nil, err
 	}
 	if resp.JSON200 == nil {
-		return nil, BuildResponseError(resp.StatusCode(), resp.Body)
+		return nil, BuildResponseError(resp.HTTPResponse, resp.Body)
 	}
 	return resp.JSON200, nil
 }
@@ -73,31 +73,31 @@ func (sc SourceReaderClient) Delete(ctx context.Context, sourceReaderUUID string
 		return err
 	}
 	if resp.StatusCode() >= 300 {
-		return BuildResponseError(resp.StatusCode(), resp.Body)
+		return BuildResponseError(resp.HTTPResponse, resp.Body)
 	}
 	return nil
 }
 
 func (sc SourceReaderClient) Deploy(ctx context.Context, sourceReaderUUID string) error {
-	resp, err := sc.client.PostSourceReadersUuidDeployWithResponse(ctx, sourceReaderUUID)
+	resp, err := sc.client.PostSourceReadersUuidDeployWithResponse(retrySafe(ctx), sourceReaderUUID)
 	if err != nil {
 		return err
 	}
 	if resp.StatusCode() >= 300 {
-		return BuildResponseError(resp.StatusCode(), resp.Body)
+		return BuildResponseError(resp.HTTPResponse, resp.Body)
 	}
 	return nil
 }
 
 func (sc SourceReaderClient) UpdateStatus(ctx context.Context, sourceReaderUUID string, status string) error {
-	resp, err := sc.client.PostSourceReadersUuidStatusWithResponse(ctx, sourceReaderUUID, openapi.RouterSourceReaderUpdateStatusRequest{
+	resp, err := sc.client.PostSourceReadersUuidStatusWithResponse(retrySafe(ctx), sourceReaderUUID, openapi.RouterSourceReaderUpdateStatusRequest{
 		Status: openapi.EnumsSourceReaderStatus(status),
 	})
 	if err != nil {
 		return err
 	}
 	if resp.StatusCode() >= 300 {
-		return BuildResponseError(resp.StatusCode(), resp.Body)
+		return BuildResponseError(resp.HTTPResponse, resp.Body)
 	}
 	return nil
 }
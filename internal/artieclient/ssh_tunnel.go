@@ -48,7 +48,7 @@ func (sc SSHTunnelClient) Update(ctx context.Context, sshTunnel SSHTunnel) (SSHT
 		return SSHTunnel{}, err
 	}
 
-	return makeRequest[SSHTunnel](ctx, sc.client, http.MethodPost, path, sshTunnel)
+	return makeRequest[SSHTunnel](retrySafe(ctx), sc.client, http.MethodPost, path, sshTunnel)
 }
 
 func (sc SSHTunnelClient) Delete(ctx context.Context, sshTunnelUUID string) error {
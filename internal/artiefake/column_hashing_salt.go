@@ -0,0 +1,96 @@
+package artiefake
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"terraform-provider-artie/internal/artieclient"
+)
+
+func (s *Server) registerColumnHashingSaltRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("POST /column-hashing-salts", s.createColumnHashingSalt)
+	mux.HandleFunc("GET /column-hashing-salts/{uuid}", s.getColumnHashingSalt)
+	mux.HandleFunc("POST /column-hashing-salts/{uuid}", s.updateColumnHashingSalt)
+	mux.HandleFunc("DELETE /column-hashing-salts/{uuid}", s.deleteColumnHashingSalt)
+}
+
+func (s *Server) createColumnHashingSalt(w http.ResponseWriter, r *http.Request) {
+	body, ok := decodeBody[artieclient.BaseColumnHashingSalt](w, r)
+	if !ok {
+		return
+	}
+	if body.Name == "" {
+		writeError(w, http.StatusBadRequest, "name is required")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	salt := body.Salt
+	if salt == "" {
+		salt = randomHex(16)
+	}
+
+	columnHashingSalt := artieclient.ColumnHashingSalt{
+		UUID:                  uuid.New(),
+		BaseColumnHashingSalt: artieclient.BaseColumnHashingSalt{Name: body.Name, Description: body.Description},
+	}
+	s.columnHashingSalts[columnHashingSalt.UUID] = columnHashingSalt
+
+	// The salt is only ever returned when it's created.
+	writeJSON(w, http.StatusOK, map[string]any{"columnHashingSalt": columnHashingSalt, "salt": salt})
+}
+
+func (s *Server) getColumnHashingSalt(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, columnHashingSalt, ok := lookup(w, r, s.columnHashingSalts, "column hashing salt"); ok {
+		writeJSON(w, http.StatusOK, columnHashingSalt)
+	}
+}
+
+func (s *Server) updateColumnHashingSalt(w http.ResponseWriter, r *http.Request) {
+	body, ok := decodeBody[artieclient.UpdateColumnHashingSaltRequest](w, r)
+	if !ok {
+		return
+	}
+	if body.Name == "" {
+		writeError(w, http.StatusBadRequest, "name is required")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id, columnHashingSalt, ok := lookup(w, r, s.columnHashingSalts, "column hashing salt")
+	if !ok {
+		return
+	}
+
+	columnHashingSalt.Name = body.Name
+	columnHashingSalt.Description = body.Description
+	s.columnHashingSalts[id] = columnHashingSalt
+	writeJSON(w, http.StatusOK, columnHashingSalt)
+}
+
+func (s *Server) deleteColumnHashingSalt(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id, _, ok := lookup(w, r, s.columnHashingSalts, "column hashing salt")
+	if !ok {
+		return
+	}
+	for _, pipeline := range s.pipelines {
+		if pipeline.ColumnHashingSaltUUID != nil && *pipeline.ColumnHashingSaltUUID == id {
+			writeError(w, http.StatusConflict, "column hashing salt is in use by pipeline %s", pipeline.UUID)
+			return
+		}
+	}
+
+	delete(s.columnHashingSalts, id)
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,157 @@
+package artiefake
+
+import (
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+
+	"terraform-provider-artie/internal/artieclient"
+	"terraform-provider-artie/internal/provider/connectors"
+)
+
+func (s *Server) registerConnectorRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("POST /connectors", s.createConnector)
+	mux.HandleFunc("POST /connectors/ping", s.pingConnector)
+	mux.HandleFunc("GET /connectors/{uuid}", s.getConnector)
+	mux.HandleFunc("POST /connectors/{uuid}", s.updateConnector)
+	mux.HandleFunc("DELETE /connectors/{uuid}", s.deleteConnector)
+}
+
+// validateConnector returns an error message if connector is invalid. Callers must hold s.mu.
+func (s *Server) validateConnector(connector artieclient.BaseConnector) string {
+	if _, err := connectors.Lookup(string(connector.Type)); err != nil {
+		return err.Error()
+	}
+	if msg := checkReference(connector.SSHTunnelUUID, s.sshTunnels, "ssh tunnel"); msg != "" {
+		return msg
+	}
+	if msg := checkReference(connector.PrivateLinkUUID, s.privateLinks, "privatelink connection"); msg != "" {
+		return msg
+	}
+	if msg := checkReference(connector.SnapshotPrivateLinkUUID, s.privateLinks, "privatelink connection"); msg != "" {
+		return msg
+	}
+	return ""
+}
+
+func (s *Server) createConnector(w http.ResponseWriter, r *http.Request) {
+	body, ok := decodeBody[artieclient.BaseConnector](w, r)
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if body.Label == "" {
+		writeError(w, http.StatusBadRequest, "label is required")
+		return
+	}
+	if msg := s.validateConnector(body); msg != "" {
+		writeError(w, http.StatusBadRequest, "%s", msg)
+		return
+	}
+	if body.DataPlaneName == "" {
+		body.DataPlaneName = DefaultDataPlaneName
+	}
+
+	now := time.Now().UTC().Truncate(time.Second)
+	connector := artieclient.ConnectorDetails{
+		Connector:       artieclient.Connector{BaseConnector: body, UUID: uuid.New()},
+		EnvironmentUUID: EnvironmentUUID,
+		IsValid:         true,
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	}
+	s.connectors[connector.UUID] = connector
+	writeJSON(w, http.StatusOK, connector)
+}
+
+func (s *Server) pingConnector(w http.ResponseWriter, r *http.Request) {
+	body, ok := decodeBody[artieclient.BaseConnector](w, r)
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	writeValidation(w, s.validateConnector(body))
+}
+
+func (s *Server) getConnector(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, connector, ok := lookup(w, r, s.connectors, "connector"); ok {
+		writeJSON(w, http.StatusOK, connector)
+	}
+}
+
+func (s *Server) updateConnector(w http.ResponseWriter, r *http.Request) {
+	body, ok := decodeBody[artieclient.Connector](w, r)
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id, existing, ok := lookup(w, r, s.connectors, "connector")
+	if !ok {
+		return
+	}
+	if body.Type != existing.Type {
+		writeError(w, http.StatusBadRequest, "the type of a connector cannot be changed")
+		return
+	}
+	if msg := s.validateConnector(body.BaseConnector); msg != "" {
+		writeError(w, http.StatusBadRequest, "%s", msg)
+		return
+	}
+	if body.DataPlaneName == "" {
+		body.DataPlaneName = existing.DataPlaneName
+	}
+
+	body.UUID = id
+	existing.Connector = body
+	existing.UpdatedAt = time.Now().UTC().Truncate(time.Second)
+	s.connectors[id] = existing
+	writeJSON(w, http.StatusOK, existing)
+}
+
+func (s *Server) deleteConnector(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id, _, ok := lookup(w, r, s.connectors, "connector")
+	if !ok {
+		return
+	}
+	for _, sourceReader := range s.sourceReaders {
+		if sourceReader.ConnectorUUID == id {
+			writeError(w, http.StatusConflict, "connector is in use by source reader %s", sourceReader.Uuid)
+			return
+		}
+	}
+	for _, pipeline := range s.pipelines {
+		if pipeline.DestinationUUID != nil && *pipeline.DestinationUUID == id {
+			writeError(w, http.StatusConflict, "connector is in use by pipeline %s", pipeline.UUID)
+			return
+		}
+	}
+
+	delete(s.connectors, id)
+	delete(s.catalogs, id)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) isConnectorOfType(id *uuid.UUID, types []string) bool {
+	if id == nil {
+		return false
+	}
+	connector, ok := s.connectors[*id]
+	return ok && slices.Contains(types, string(connector.Type))
+}
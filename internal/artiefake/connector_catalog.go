@@ -0,0 +1,130 @@
+package artiefake
+
+import (
+	"net/http"
+	"slices"
+
+	"github.com/google/uuid"
+
+	"terraform-provider-artie/internal/lib"
+	"terraform-provider-artie/internal/openapi"
+)
+
+// Catalog holds the tables that a connector can see, keyed by database name.
+type Catalog map[string][]openapi.RouterConnectorTable
+
+func (s *Server) registerConnectorCatalogRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("POST /connectors/databases", s.listConnectorDatabases)
+	mux.HandleFunc("POST /connectors/schemas", s.listConnectorSchemas)
+	mux.HandleFunc("POST /connectors/tables", s.listConnectorTables)
+}
+
+// SetCatalog sets the databases and tables that the connector with the given UUID can see.
+func (s *Server) SetCatalog(connectorUUID uuid.UUID, catalog Catalog) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.catalogs[connectorUUID] = catalog
+}
+
+// lookupCatalog finds the catalog of the connector in payload, responding with a 404 if the connector doesn't exist.
+// Callers must hold s.mu.
+func (s *Server) lookupCatalog(w http.ResponseWriter, payload openapi.PayloadsConnectorPayload) (Catalog, bool) {
+	id, err := uuid.Parse(lib.RemovePtr(payload.Uuid))
+	if _, ok := s.connectors[id]; err != nil || !ok {
+		writeError(w, http.StatusNotFound, "connector not found")
+		return nil, false
+	}
+	return s.catalogs[id], true
+}
+
+// lookupDatabase finds the tables of a database in the catalog of the connector in payload, responding with an error
+// if either doesn't exist. Callers must hold s.mu.
+func (s *Server) lookupDatabase(w http.ResponseWriter, payload openapi.PayloadsConnectorPayload, databaseName string) ([]openapi.RouterConnectorTable, bool) {
+	catalog, ok := s.lookupCatalog(w, payload)
+	if !ok {
+		return nil, false
+	}
+	tables, ok := catalog[databaseName]
+	if !ok {
+		writeError(w, http.StatusBadRequest, "database %q does not exist", databaseName)
+		return nil, false
+	}
+	return tables, true
+}
+
+func nameItems(names []string) openapi.PayloadsConnectorFetchDatabasesResponse {
+	items := []openapi.PayloadsDatabaseListItem{}
+	for _, name := range names {
+		items = append(items, openapi.PayloadsDatabaseListItem{Name: lib.ToPtr(name)})
+	}
+	return openapi.PayloadsConnectorFetchDatabasesResponse{Items: &items}
+}
+
+func (s *Server) listConnectorDatabases(w http.ResponseWriter, r *http.Request) {
+	body, ok := decodeBody[openapi.PayloadsConnectorPayload](w, r)
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	catalog, ok := s.lookupCatalog(w, body)
+	if !ok {
+		return
+	}
+
+	var databases []string
+	for database := range catalog {
+		databases = append(databases, database)
+	}
+	slices.Sort(databases)
+	writeJSON(w, http.StatusOK, nameItems(databases))
+}
+
+func (s *Server) listConnectorSchemas(w http.ResponseWriter, r *http.Request) {
+	body, ok := decodeBody[openapi.RouterConnectorFetchSchemasRequest](w, r)
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tables, ok := s.lookupDatabase(w, body.Connector, body.DatabaseName)
+	if !ok {
+		return
+	}
+
+	var schemas []string
+	for _, table := range tables {
+		if schema := lib.RemovePtr(table.Schema); schema != "" && !slices.Contains(schemas, schema) {
+			schemas = append(schemas, schema)
+		}
+	}
+	slices.Sort(schemas)
+	writeJSON(w, http.StatusOK, nameItems(schemas))
+}
+
+func (s *Server) listConnectorTables(w http.ResponseWriter, r *http.Request) {
+	body, ok := decodeBody[openapi.RouterConnectorFetchTablesRequest](w, r)
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tables, ok := s.lookupDatabase(w, body.Connector, body.DatabaseName)
+	if !ok {
+		return
+	}
+
+	matches := []openapi.RouterConnectorTable{}
+	for _, table := range tables {
+		if body.SchemaName == nil || lib.RemovePtr(table.Schema) == *body.SchemaName {
+			matches = append(matches, table)
+		}
+	}
+	writeJSON(w, http.StatusOK, openapi.ListResponseBodyConnectorTable{Items: matches})
+}
@@ -0,0 +1,105 @@
+package artiefake
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"terraform-provider-artie/internal/artieclient"
+)
+
+func (s *Server) registerEncryptionKeyRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("POST /encryption-keys", s.createEncryptionKey)
+	mux.HandleFunc("GET /encryption-keys/{uuid}", s.getEncryptionKey)
+	mux.HandleFunc("POST /encryption-keys/{uuid}", s.updateEncryptionKey)
+	mux.HandleFunc("DELETE /encryption-keys/{uuid}", s.deleteEncryptionKey)
+}
+
+func randomHex(n int) string {
+	b := make([]byte, n)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+func (s *Server) createEncryptionKey(w http.ResponseWriter, r *http.Request) {
+	body, ok := decodeBody[artieclient.BaseEncryptionKey](w, r)
+	if !ok {
+		return
+	}
+	if body.Name == "" {
+		writeError(w, http.StatusBadRequest, "name is required")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	encryptionKey := artieclient.EncryptionKey{
+		UUID:        uuid.New(),
+		Name:        body.Name,
+		Description: body.Description,
+		Type:        "passphrase",
+		KMSKeyUUID:  body.KMSKeyUUID,
+	}
+	if body.KMSKeyUUID != nil {
+		encryptionKey.Type = "kms"
+	}
+	s.encryptionKeys[encryptionKey.UUID] = encryptionKey
+
+	// Key material is only ever returned when the key is created.
+	writeJSON(w, http.StatusOK, map[string]any{"encryptionKey": encryptionKey, "key": randomHex(32)})
+}
+
+func (s *Server) getEncryptionKey(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, encryptionKey, ok := lookup(w, r, s.encryptionKeys, "encryption key"); ok {
+		writeJSON(w, http.StatusOK, encryptionKey)
+	}
+}
+
+func (s *Server) updateEncryptionKey(w http.ResponseWriter, r *http.Request) {
+	body, ok := decodeBody[artieclient.UpdateEncryptionKeyRequest](w, r)
+	if !ok {
+		return
+	}
+	if body.Name == "" {
+		writeError(w, http.StatusBadRequest, "name is required")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id, encryptionKey, ok := lookup(w, r, s.encryptionKeys, "encryption key")
+	if !ok {
+		return
+	}
+
+	encryptionKey.Name = body.Name
+	encryptionKey.Description = body.Description
+	s.encryptionKeys[id] = encryptionKey
+	writeJSON(w, http.StatusOK, encryptionKey)
+}
+
+func (s *Server) deleteEncryptionKey(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id, _, ok := lookup(w, r, s.encryptionKeys, "encryption key")
+	if !ok {
+		return
+	}
+	for _, pipeline := range s.pipelines {
+		if pipeline.EncryptionKeyUUID != nil && *pipeline.EncryptionKeyUUID == id {
+			writeError(w, http.StatusConflict, "encryption key is in use by pipeline %s", pipeline.UUID)
+			return
+		}
+	}
+
+	delete(s.encryptionKeys, id)
+	w.WriteHeader(http.StatusNoContent)
+}
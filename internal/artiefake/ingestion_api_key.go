@@ -0,0 +1,88 @@
+package artiefake
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+
+	"terraform-provider-artie/internal/lib"
+	"terraform-provider-artie/internal/openapi"
+)
+
+func (s *Server) registerIngestionAPIKeyRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("POST /ingestion-api-keys", s.createIngestionAPIKey)
+	mux.HandleFunc("POST /ingestion-api-keys/{uuid}", s.updateIngestionAPIKey)
+	mux.HandleFunc("DELETE /ingestion-api-keys/{uuid}", s.deleteIngestionAPIKey)
+}
+
+func (s *Server) createIngestionAPIKey(w http.ResponseWriter, r *http.Request) {
+	body, ok := decodeBody[openapi.RouterCreateIngestionAPIKeyRequest](w, r)
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.sourceReaders[body.SourceReaderUUID]; !ok {
+		writeError(w, http.StatusBadRequest, "source reader %s does not exist", body.SourceReaderUUID)
+		return
+	}
+
+	secret := "arik_" + randomHex(16)
+	now := time.Now().UTC().Truncate(time.Second)
+	ingestionAPIKey := openapi.PayloadsIngestionAPIKey{
+		Uuid:             uuid.New(),
+		Name:             lib.RemovePtr(body.Name),
+		SourceReaderUUID: body.SourceReaderUUID,
+		Last4:            secret[len(secret)-4:],
+		CreatedAt:        now,
+		UpdatedAt:        now,
+	}
+	s.ingestionAPIKeys[ingestionAPIKey.Uuid] = ingestionAPIKey
+
+	// The secret is only ever returned when the key is created.
+	writeJSON(w, http.StatusOK, openapi.RouterCreateIngestionAPIKeyResponse{IngestionAPIKey: ingestionAPIKey, Secret: secret})
+}
+
+func (s *Server) updateIngestionAPIKey(w http.ResponseWriter, r *http.Request) {
+	body, ok := decodeBody[openapi.RouterUpdateIngestionAPIKeyRequest](w, r)
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id, ingestionAPIKey, ok := lookup(w, r, s.ingestionAPIKeys, "ingestion API key")
+	if !ok {
+		return
+	}
+
+	// Fields that are omitted from the request are left unchanged.
+	if body.Name != nil {
+		ingestionAPIKey.Name = *body.Name
+		ingestionAPIKey.UpdatedAt = time.Now().UTC().Truncate(time.Second)
+	}
+	if body.ExpiresAt != nil {
+		expiresAt := body.ExpiresAt.UTC()
+		ingestionAPIKey.ExpiresAt = &expiresAt
+		ingestionAPIKey.UpdatedAt = time.Now().UTC().Truncate(time.Second)
+	}
+	s.ingestionAPIKeys[id] = ingestionAPIKey
+	writeJSON(w, http.StatusOK, ingestionAPIKey)
+}
+
+func (s *Server) deleteIngestionAPIKey(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id, _, ok := lookup(w, r, s.ingestionAPIKeys, "ingestion API key")
+	if !ok {
+		return
+	}
+
+	delete(s.ingestionAPIKeys, id)
+	w.WriteHeader(http.StatusNoContent)
+}
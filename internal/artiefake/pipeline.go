@@ -0,0 +1,428 @@
+package artiefake
+
+import (
+	"cmp"
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+
+	"terraform-provider-artie/internal/artieclient"
+	"terraform-provider-artie/internal/lib"
+	"terraform-provider-artie/internal/openapi"
+	"terraform-provider-artie/internal/provider/connectors"
+)
+
+func (s *Server) registerPipelineRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET /pipelines", s.listPipelines)
+	mux.HandleFunc("POST /pipelines", s.createPipeline)
+	mux.HandleFunc("POST /pipelines/validate-unsaved-source", s.validatePipelineSource)
+	mux.HandleFunc("POST /pipelines/validate-unsaved-destination", s.validatePipelineDestination)
+	mux.HandleFunc("GET /pipelines/{uuid}", s.getPipeline)
+	mux.HandleFunc("POST /pipelines/{uuid}", s.updatePipeline)
+	mux.HandleFunc("DELETE /pipelines/{uuid}", s.deletePipeline)
+	mux.HandleFunc("POST /pipelines/{uuid}/start", s.startPipeline)
+	mux.HandleFunc("POST /pipelines/{uuid}/status", s.updatePipelineStatus)
+}
+
+// pipelineRuntime is the part of a pipeline's state that's managed by Artie rather than set through the API.
+type pipelineRuntime struct {
+	status               openapi.EnumsPipelineStatus
+	createdAt            time.Time
+	lastUpdatedAt        time.Time
+	lastDeployedAt       *time.Time
+	isDeploying          bool
+	hasUndeployedChanges bool
+	failDeployments      bool
+	// backfilling is set if the pipeline was last started with a backfill.
+	backfilling bool
+	// pendingStatus is set by a status change that hasn't taken effect yet.
+	pendingStatus openapi.EnumsPipelineStatus
+}
+
+// FailDeployments makes every later deployment of the pipeline with the given UUID fail, leaving its changes
+// undeployed.
+func (s *Server) FailDeployments(pipelineUUID uuid.UUID) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	runtime := s.pipelineRuntimes[pipelineUUID]
+	runtime.failDeployments = true
+	s.pipelineRuntimes[pipelineUUID] = runtime
+}
+
+type pipelineRequest[T any] struct {
+	Pipeline T `json:"pipeline"`
+}
+
+type validatePipelineRequest struct {
+	SourceReaderUUID *uuid.UUID          `json:"sourceReaderUUID"`
+	DestinationUUID  *uuid.UUID          `json:"destinationUUID"`
+	Tables           []artieclient.Table `json:"tables"`
+}
+
+// checkPipelineSource returns an error message if the pipeline's source reader or tables are invalid. Callers must hold
+// s.mu.
+func (s *Server) checkPipelineSource(sourceReaderUUID *uuid.UUID, tables []artieclient.Table) string {
+	if sourceReaderUUID == nil || *sourceReaderUUID == uuid.Nil {
+		return "source reader is required"
+	}
+	if msg := checkReference(sourceReaderUUID, s.sourceReaders, "source reader"); msg != "" {
+		return msg
+	}
+	if len(tables) == 0 {
+		return "at least one table is required"
+	}
+
+	seen := map[string]bool{}
+	for _, table := range tables {
+		if table.Name == "" {
+			return "table name is required"
+		}
+		key := table.Schema + "." + table.Name
+		if seen[key] {
+			return fmt.Sprintf("table %q is specified more than once", key)
+		}
+		seen[key] = true
+	}
+
+	// Tables are only checked against the source's catalog if a test has set one.
+	sourceReader := s.sourceReaders[*sourceReaderUUID]
+	if catalog, ok := s.catalogs[sourceReader.ConnectorUUID]; ok {
+		for _, table := range tables {
+			if !slices.ContainsFunc(catalog[sourceReader.Database], func(catalogTable openapi.RouterConnectorTable) bool {
+				return lib.RemovePtr(catalogTable.Schema) == table.Schema && lib.RemovePtr(catalogTable.Name) == table.Name
+			}) {
+				return fmt.Sprintf("table %q does not exist in database %q", table.Schema+"."+table.Name, sourceReader.Database)
+			}
+		}
+	}
+	return ""
+}
+
+// checkPipelineDestination returns an error message if the pipeline's destination is invalid. Callers must hold s.mu.
+func (s *Server) checkPipelineDestination(destinationUUID *uuid.UUID) string {
+	if destinationUUID == nil || *destinationUUID == uuid.Nil {
+		return "destination is required"
+	}
+	if msg := checkReference(destinationUUID, s.connectors, "connector"); msg != "" {
+		return msg
+	}
+	if !s.isConnectorOfType(destinationUUID, connectors.DestinationTypes()) {
+		return fmt.Sprintf("connector %s is not a destination connector", destinationUUID)
+	}
+	return ""
+}
+
+// checkPipeline returns an error message if pipeline is invalid. Callers must hold s.mu.
+func (s *Server) checkPipeline(pipeline artieclient.BasePipeline) string {
+	if pipeline.Name == "" {
+		return "name is required"
+	}
+	if msg := s.checkPipelineSource(pipeline.SourceReaderUUID, pipeline.Tables); msg != "" {
+		return msg
+	}
+	if msg := s.checkPipelineDestination(pipeline.DestinationUUID); msg != "" {
+		return msg
+	}
+	if msg := checkReference(pipeline.EncryptionKeyUUID, s.encryptionKeys, "encryption key"); msg != "" {
+		return msg
+	}
+	if msg := checkReference(pipeline.ColumnHashingSaltUUID, s.columnHashingSalts, "column hashing salt"); msg != "" {
+		return msg
+	}
+	return ""
+}
+
+// assignTableUUIDs gives new tables a UUID, and keeps the UUID of tables that already existed in the pipeline.
+func assignTableUUIDs(tables []artieclient.Table, existing []artieclient.Table) {
+	existingUUIDs := map[string]uuid.UUID{}
+	for _, table := range existing {
+		existingUUIDs[table.Schema+"."+table.Name] = table.UUID
+	}
+
+	for i, table := range tables {
+		if table.UUID != uuid.Nil {
+			continue
+		}
+		if id, ok := existingUUIDs[table.Schema+"."+table.Name]; ok {
+			tables[i].UUID = id
+		} else {
+			tables[i].UUID = uuid.New()
+		}
+	}
+}
+
+// Artie's default flush rules, which are used for pipelines that don't set their own.
+const (
+	defaultFlushIntervalSeconds = 30
+	defaultBufferRows           = 150_000
+	defaultFlushSizeKB          = 50_000
+)
+
+func withPipelineDefaults(pipeline artieclient.BasePipeline) artieclient.BasePipeline {
+	var settings artieclient.AdvancedSettings
+	if pipeline.AdvancedSettings != nil {
+		settings = *pipeline.AdvancedSettings
+	}
+	if settings.FlushIntervalSeconds == nil {
+		settings.FlushIntervalSeconds = lib.ToPtr[int64](defaultFlushIntervalSeconds)
+	}
+	if settings.BufferRows == nil {
+		settings.BufferRows = lib.ToPtr[int64](defaultBufferRows)
+	}
+	if settings.FlushSizeKB == nil {
+		settings.FlushSizeKB = lib.ToPtr[int64](defaultFlushSizeKB)
+	}
+	pipeline.AdvancedSettings = &settings
+	return pipeline
+}
+
+func (s *Server) createPipeline(w http.ResponseWriter, r *http.Request) {
+	body, ok := decodeBody[pipelineRequest[artieclient.BasePipeline]](w, r)
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if msg := s.checkPipeline(body.Pipeline); msg != "" {
+		writeError(w, http.StatusBadRequest, "%s", msg)
+		return
+	}
+
+	pipeline := artieclient.Pipeline{BasePipeline: withPipelineDefaults(body.Pipeline), UUID: uuid.New()}
+	if pipeline.DataPlaneName == "" {
+		pipeline.DataPlaneName = s.sourceReaders[*pipeline.SourceReaderUUID].DataPlaneName
+	}
+	assignTableUUIDs(pipeline.Tables, nil)
+
+	s.pipelines[pipeline.UUID] = pipeline
+	now := time.Now().UTC().Truncate(time.Second)
+	s.pipelineRuntimes[pipeline.UUID] = pipelineRuntime{status: openapi.EnumsPipelineStatusDraft, createdAt: now, lastUpdatedAt: now, hasUndeployedChanges: true}
+	writeJSON(w, http.StatusOK, s.pipelineDetails(pipeline))
+}
+
+// pipelineSourceType returns the type of the connector that a pipeline reads from. Callers must hold s.mu.
+func (s *Server) pipelineSourceType(pipeline artieclient.Pipeline) artieclient.ConnectorType {
+	sourceReader, ok := s.sourceReaders[*pipeline.SourceReaderUUID]
+	if !ok {
+		return ""
+	}
+	return s.connectors[sourceReader.ConnectorUUID].Type
+}
+
+// pipelineDetails returns a pipeline along with its runtime state. Every table has the same status as the pipeline.
+// Callers must hold s.mu.
+func (s *Server) pipelineDetails(pipeline artieclient.Pipeline) artieclient.PipelineDetails {
+	runtime := s.pipelineRuntimes[pipeline.UUID]
+	pipeline.Tables = slices.Clone(pipeline.Tables)
+	for i, table := range pipeline.Tables {
+		status := string(runtime.status)
+		pipeline.Tables[i].Status = &status
+		if table.EnableHistoryMode {
+			pipeline.Tables[i].HistoryTableStatus = &status
+		}
+	}
+
+	return artieclient.PipelineDetails{
+		Pipeline:             pipeline,
+		SourceType:           s.pipelineSourceType(pipeline),
+		Status:               runtime.status,
+		IsDeploying:          runtime.isDeploying,
+		HasUndeployedChanges: runtime.hasUndeployedChanges,
+		HasBackfillingTables: runtime.backfilling,
+		LastDeployedAt:       runtime.lastDeployedAt,
+		LastUpdatedAt:        runtime.lastUpdatedAt,
+	}
+}
+
+// lightPipeline returns the summary of a pipeline that's returned when listing pipelines. Callers must hold s.mu.
+func (s *Server) lightPipeline(pipeline artieclient.Pipeline) openapi.PayloadsLightPipeline {
+	runtime := s.pipelineRuntimes[pipeline.UUID]
+	return openapi.PayloadsLightPipeline{
+		Uuid:                  pipeline.UUID,
+		Name:                  pipeline.Name,
+		DataPlaneName:         pipeline.DataPlaneName,
+		SourceReaderUUID:      pipeline.SourceReaderUUID,
+		SourceType:            openapi.EnumsConnectorSlug(s.pipelineSourceType(pipeline)),
+		DestinationUUID:       pipeline.DestinationUUID,
+		EncryptionKeyUUID:     pipeline.EncryptionKeyUUID,
+		ColumnHashingSaltUUID: pipeline.ColumnHashingSaltUUID,
+		Status:                &runtime.status,
+		IsDeploying:           runtime.isDeploying,
+		HasUndeployedChanges:  runtime.hasUndeployedChanges,
+		CreatedAt:             runtime.createdAt,
+		LastUpdatedAt:         runtime.lastUpdatedAt,
+		LastDeployedAt:        runtime.lastDeployedAt,
+	}
+}
+
+func (s *Server) listPipelines(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	pipelines := []openapi.PayloadsLightPipeline{}
+	for _, pipeline := range slices.SortedFunc(maps.Values(s.pipelines), func(a, b artieclient.Pipeline) int {
+		return cmp.Compare(a.UUID.String(), b.UUID.String())
+	}) {
+		pipelines = append(pipelines, s.lightPipeline(pipeline))
+	}
+	writeJSON(w, http.StatusOK, openapi.ListResponseBodyLightPipeline{Items: pipelines})
+}
+
+func (s *Server) validatePipelineSource(w http.ResponseWriter, r *http.Request) {
+	body, ok := decodeBody[validatePipelineRequest](w, r)
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	writeValidation(w, s.checkPipelineSource(body.SourceReaderUUID, body.Tables))
+}
+
+func (s *Server) validatePipelineDestination(w http.ResponseWriter, r *http.Request) {
+	body, ok := decodeBody[validatePipelineRequest](w, r)
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	writeValidation(w, s.checkPipelineDestination(body.DestinationUUID))
+}
+
+func (s *Server) getPipeline(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id, pipeline, ok := lookup(w, r, s.pipelines, "pipeline")
+	if !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, s.pipelineDetails(pipeline))
+
+	// Deployments and status changes finish once they've been seen in progress, so that callers waiting for them see
+	// both states.
+	runtime := s.pipelineRuntimes[id]
+	if runtime.isDeploying {
+		runtime.isDeploying = false
+		if !runtime.failDeployments {
+			now := time.Now().UTC().Truncate(time.Second)
+			runtime.hasUndeployedChanges = false
+			runtime.lastDeployedAt = &now
+		}
+	}
+	if runtime.pendingStatus != "" {
+		runtime.status = runtime.pendingStatus
+		runtime.pendingStatus = ""
+	}
+	s.pipelineRuntimes[id] = runtime
+}
+
+func (s *Server) updatePipeline(w http.ResponseWriter, r *http.Request) {
+	body, ok := decodeBody[pipelineRequest[artieclient.Pipeline]](w, r)
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id, existing, ok := lookup(w, r, s.pipelines, "pipeline")
+	if !ok {
+		return
+	}
+
+	pipeline := body.Pipeline
+	if msg := s.checkPipeline(pipeline.BasePipeline); msg != "" {
+		writeError(w, http.StatusBadRequest, "%s", msg)
+		return
+	}
+	pipeline.BasePipeline = withPipelineDefaults(pipeline.BasePipeline)
+	if pipeline.DataPlaneName == "" {
+		pipeline.DataPlaneName = existing.DataPlaneName
+	}
+	assignTableUUIDs(pipeline.Tables, existing.Tables)
+
+	pipeline.UUID = id
+	s.pipelines[id] = pipeline
+	runtime := s.pipelineRuntimes[id]
+	runtime.lastUpdatedAt = time.Now().UTC().Truncate(time.Second)
+	runtime.hasUndeployedChanges = true
+	s.pipelineRuntimes[id] = runtime
+	writeJSON(w, http.StatusOK, s.pipelineDetails(pipeline))
+}
+
+func (s *Server) deletePipeline(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id, _, ok := lookup(w, r, s.pipelines, "pipeline")
+	if !ok {
+		return
+	}
+
+	delete(s.pipelines, id)
+	delete(s.pipelineRuntimes, id)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) startPipeline(w http.ResponseWriter, r *http.Request) {
+	body, ok := decodeBody[openapi.RouterPipelineStartRequest](w, r)
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id, _, ok := lookup(w, r, s.pipelines, "pipeline")
+	if !ok {
+		return
+	}
+
+	runtime := s.pipelineRuntimes[id]
+	keepPaused := body.KeepPaused != nil && *body.KeepPaused
+	disableBackfill := body.DisableBackFill != nil && *body.DisableBackFill
+	runtime.status = openapi.EnumsPipelineStatusRunning
+	if keepPaused {
+		runtime.status = openapi.EnumsPipelineStatusPaused
+	}
+	runtime.backfilling = !keepPaused && !disableBackfill
+	runtime.isDeploying = true
+	runtime.pendingStatus = ""
+	s.pipelineRuntimes[id] = runtime
+	writeJSON(w, http.StatusOK, map[string]string{})
+}
+
+func (s *Server) updatePipelineStatus(w http.ResponseWriter, r *http.Request) {
+	body, ok := decodeBody[openapi.RouterPipelineUpdateStatusRequest](w, r)
+	if !ok {
+		return
+	}
+	if !body.Status.Valid() {
+		writeError(w, http.StatusBadRequest, "invalid status %q", body.Status)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id, _, ok := lookup(w, r, s.pipelines, "pipeline")
+	if !ok {
+		return
+	}
+
+	runtime := s.pipelineRuntimes[id]
+	runtime.pendingStatus = body.Status
+	s.pipelineRuntimes[id] = runtime
+	writeJSON(w, http.StatusOK, map[string]string{})
+}
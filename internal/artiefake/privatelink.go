@@ -0,0 +1,128 @@
+package artiefake
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"terraform-provider-artie/internal/artieclient"
+)
+
+func (s *Server) registerPrivateLinkRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("POST /privatelink-connections", s.createPrivateLink)
+	mux.HandleFunc("GET /privatelink-connections/{uuid}", s.getPrivateLink)
+	mux.HandleFunc("POST /privatelink-connections/{uuid}", s.updatePrivateLink)
+	mux.HandleFunc("DELETE /privatelink-connections/{uuid}", s.deletePrivateLink)
+}
+
+// regionFromServiceName extracts the region from a VPC endpoint service name such as
+// com.amazonaws.vpce.us-east-1.vpce-svc-0123456789abcdef0.
+func regionFromServiceName(serviceName string) (string, bool) {
+	parts := strings.Split(serviceName, ".")
+	if len(parts) != 5 || parts[0] != "com" || parts[1] != "amazonaws" || parts[2] != "vpce" || !strings.HasPrefix(parts[4], "vpce-svc-") {
+		return "", false
+	}
+	return parts[3], true
+}
+
+func validatePrivateLink(conn artieclient.BasePrivateLinkConnection) string {
+	switch {
+	case conn.Name == "":
+		return "name is required"
+	case len(conn.AzIDs) == 0:
+		return "at least one availability zone ID is required"
+	}
+	if _, ok := regionFromServiceName(conn.VpcServiceName); !ok {
+		return fmt.Sprintf("%q is not a valid VPC endpoint service name", conn.VpcServiceName)
+	}
+	return ""
+}
+
+func (s *Server) createPrivateLink(w http.ResponseWriter, r *http.Request) {
+	body, ok := decodeBody[artieclient.BasePrivateLinkConnection](w, r)
+	if !ok {
+		return
+	}
+	if msg := validatePrivateLink(body); msg != "" {
+		writeError(w, http.StatusBadRequest, "%s", msg)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := uuid.New()
+	endpointID := "vpce-" + strings.ReplaceAll(id.String(), "-", "")[:17]
+	conn := artieclient.PrivateLinkConnection{BasePrivateLinkConnection: body, UUID: id, Status: "available"}
+	conn.Region, _ = regionFromServiceName(body.VpcServiceName)
+	conn.VpcEndpointID = endpointID
+	conn.DnsEntry = fmt.Sprintf("%s.%s.vpce.amazonaws.com", endpointID, conn.Region)
+	if conn.DataPlaneName == "" {
+		conn.DataPlaneName = DefaultDataPlaneName
+	}
+
+	s.privateLinks[id] = conn
+	writeJSON(w, http.StatusOK, conn)
+}
+
+func (s *Server) getPrivateLink(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, conn, ok := lookup(w, r, s.privateLinks, "privatelink connection"); ok {
+		writeJSON(w, http.StatusOK, conn)
+	}
+}
+
+func (s *Server) updatePrivateLink(w http.ResponseWriter, r *http.Request) {
+	body, ok := decodeBody[artieclient.PrivateLinkConnection](w, r)
+	if !ok {
+		return
+	}
+	if msg := validatePrivateLink(body.BasePrivateLinkConnection); msg != "" {
+		writeError(w, http.StatusBadRequest, "%s", msg)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id, existing, ok := lookup(w, r, s.privateLinks, "privatelink connection")
+	if !ok {
+		return
+	}
+	if body.VpcServiceName != existing.VpcServiceName {
+		writeError(w, http.StatusBadRequest, "the VPC endpoint service name of a privatelink connection cannot be changed")
+		return
+	}
+
+	// Only the name and availability zones can be changed; everything else is managed by Artie.
+	conn := existing
+	conn.Name = body.Name
+	conn.AzIDs = body.AzIDs
+	s.privateLinks[id] = conn
+	writeJSON(w, http.StatusOK, conn)
+}
+
+func (s *Server) deletePrivateLink(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id, _, ok := lookup(w, r, s.privateLinks, "privatelink connection")
+	if !ok {
+		return
+	}
+	for _, connector := range s.connectors {
+		for _, linkUUID := range []*uuid.UUID{connector.PrivateLinkUUID, connector.SnapshotPrivateLinkUUID} {
+			if linkUUID != nil && *linkUUID == id {
+				writeError(w, http.StatusConflict, "privatelink connection is in use by connector %s", connector.UUID)
+				return
+			}
+		}
+	}
+
+	delete(s.privateLinks, id)
+	w.WriteHeader(http.StatusNoContent)
+}
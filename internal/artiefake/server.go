@@ -0,0 +1,145 @@
+// Package artiefake provides an in-memory fake of the Artie API for tests. It implements the routes that the provider
+// uses for each resource, keeps all objects in memory, and returns the same kinds of errors as the real API, so that
+// acceptance tests can exercise full resource lifecycles without an Artie account.
+package artiefake
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+
+	"github.com/google/uuid"
+
+	"terraform-provider-artie/internal/artieclient"
+	"terraform-provider-artie/internal/openapi"
+)
+
+// APIKey is an API key that the fake server accepts. Any key with the arsk_ prefix is accepted.
+const APIKey = "arsk_artiefake"
+
+// DefaultDataPlaneName is used for objects that are created without a data plane.
+const DefaultDataPlaneName = "aws-us-east-1"
+
+// EnvironmentUUID is the UUID of the environment that every object is created in.
+var EnvironmentUUID = uuid.MustParse("7f1c2d4e-5a6b-4c8d-9e0f-1a2b3c4d5e6f")
+
+type Server struct {
+	*httptest.Server
+
+	mu                 sync.Mutex
+	connectors         map[uuid.UUID]artieclient.ConnectorDetails
+	sshTunnels         map[uuid.UUID]artieclient.SSHTunnel
+	sourceReaders      map[uuid.UUID]openapi.PayloadsSourceReader
+	pipelines          map[uuid.UUID]artieclient.Pipeline
+	pipelineRuntimes   map[uuid.UUID]pipelineRuntime
+	privateLinks       map[uuid.UUID]artieclient.PrivateLinkConnection
+	encryptionKeys     map[uuid.UUID]artieclient.EncryptionKey
+	columnHashingSalts map[uuid.UUID]artieclient.ColumnHashingSalt
+	catalogs           map[uuid.UUID]Catalog
+	ingestionAPIKeys   map[uuid.UUID]openapi.PayloadsIngestionAPIKey
+}
+
+// NewServer starts a fake Artie API server. Callers should call Close when they're done with it.
+func NewServer() *Server {
+	s := &Server{
+		connectors:         map[uuid.UUID]artieclient.ConnectorDetails{},
+		sshTunnels:         map[uuid.UUID]artieclient.SSHTunnel{},
+		sourceReaders:      map[uuid.UUID]openapi.PayloadsSourceReader{},
+		pipelines:          map[uuid.UUID]artieclient.Pipeline{},
+		pipelineRuntimes:   map[uuid.UUID]pipelineRuntime{},
+		privateLinks:       map[uuid.UUID]artieclient.PrivateLinkConnection{},
+		encryptionKeys:     map[uuid.UUID]artieclient.EncryptionKey{},
+		columnHashingSalts: map[uuid.UUID]artieclient.ColumnHashingSalt{},
+		catalogs:           map[uuid.UUID]Catalog{},
+		ingestionAPIKeys:   map[uuid.UUID]openapi.PayloadsIngestionAPIKey{},
+	}
+
+	mux := http.NewServeMux()
+	s.registerConnectorRoutes(mux)
+	s.registerConnectorCatalogRoutes(mux)
+	s.registerSSHTunnelRoutes(mux)
+	s.registerSourceReaderRoutes(mux)
+	s.registerPipelineRoutes(mux)
+	s.registerPrivateLinkRoutes(mux)
+	s.registerEncryptionKeyRoutes(mux)
+	s.registerColumnHashingSaltRoutes(mux)
+	s.registerIngestionAPIKeyRoutes(mux)
+
+	s.Server = httptest.NewServer(s.authenticate(mux))
+	return s
+}
+
+// ObjectCount returns the total number of objects stored by the server, which is useful for checking that
+// everything was cleaned up after a test.
+func (s *Server) ObjectCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.connectors) + len(s.sshTunnels) + len(s.sourceReaders) + len(s.pipelines) + len(s.privateLinks) +
+		len(s.encryptionKeys) + len(s.columnHashingSalts) + len(s.ingestionAPIKeys)
+}
+
+func (s *Server) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.Header.Get("Authorization"), "Bearer arsk_") {
+			writeError(w, http.StatusUnauthorized, "invalid API key")
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func writeJSON(w http.ResponseWriter, statusCode int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func writeError(w http.ResponseWriter, statusCode int, format string, args ...any) {
+	writeJSON(w, statusCode, map[string]string{"error": fmt.Sprintf(format, args...)})
+}
+
+// writeValidation responds to a validate or ping request, which reports failures in the body of a 200 response.
+func writeValidation(w http.ResponseWriter, validationErr string) {
+	writeJSON(w, http.StatusOK, map[string]string{"error": validationErr})
+}
+
+func decodeBody[T any](w http.ResponseWriter, r *http.Request) (T, bool) {
+	var body T
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body: %s", err)
+		return body, false
+	}
+	return body, true
+}
+
+// lookup finds the object whose UUID is in the request path, responding with a 404 if it doesn't exist. Callers must
+// hold s.mu.
+func lookup[T any](w http.ResponseWriter, r *http.Request, objects map[uuid.UUID]T, kind string) (uuid.UUID, T, bool) {
+	id, err := uuid.Parse(r.PathValue("uuid"))
+	if err != nil {
+		var zero T
+		writeError(w, http.StatusNotFound, "%s not found", kind)
+		return uuid.UUID{}, zero, false
+	}
+
+	object, ok := objects[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "%s not found", kind)
+		return uuid.UUID{}, object, false
+	}
+	return id, object, true
+}
+
+// checkReference returns an error message if id is set but doesn't refer to an existing object.
+func checkReference[T any](id *uuid.UUID, objects map[uuid.UUID]T, kind string) string {
+	if id == nil || *id == uuid.Nil {
+		return ""
+	}
+	if _, ok := objects[*id]; !ok {
+		return fmt.Sprintf("%s %s does not exist", kind, id)
+	}
+	return ""
+}
@@ -0,0 +1,219 @@
+package artiefake
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"terraform-provider-artie/internal/artieclient"
+	"terraform-provider-artie/internal/lib"
+	"terraform-provider-artie/internal/openapi"
+	"terraform-provider-artie/internal/provider/connectors"
+)
+
+func newTestClients(t *testing.T, server *Server) (artieclient.Client, *openapi.ClientWithResponses) {
+	client, err := artieclient.New(server.URL, APIKey, "test", artieclient.RetryConfig{})
+	require.NoError(t, err)
+
+	openAPIClient, err := openapi.NewClientWithResponses(server.URL, openapi.WithRequestEditorFn(
+		func(ctx context.Context, req *http.Request) error {
+			req.Header.Set("Authorization", "Bearer "+APIKey)
+			return nil
+		},
+	))
+	require.NoError(t, err)
+	return client, openAPIClient
+}
+
+func TestServer_Authentication(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	req, err := http.NewRequest(http.MethodGet, server.URL+"/connectors/"+uuid.NewString(), nil)
+	require.NoError(t, err)
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+}
+
+func TestServer_Lifecycle(t *testing.T) {
+	ctx := t.Context()
+	server := NewServer()
+	defer server.Close()
+	client, openAPIClient := newTestClients(t, server)
+
+	sshTunnel, err := client.SSHTunnels().Create(ctx, artieclient.BaseSSHTunnel{Name: "tunnel", Host: "1.2.3.4", Port: 22, Username: "artie"})
+	require.NoError(t, err)
+	assert.NotEmpty(t, sshTunnel.PublicKey)
+
+	source, err := client.Connectors().Create(ctx, artieclient.BaseConnector{
+		Type:          connectors.PostgreSQL,
+		Label:         "postgres",
+		SSHTunnelUUID: &sshTunnel.UUID,
+		Config:        artieclient.ConnectorConfig{"host": "db.example.com", "port": 5432, "user": "artie"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, DefaultDataPlaneName, source.DataPlaneName)
+
+	destination, err := client.Connectors().Create(ctx, artieclient.BaseConnector{Type: connectors.Snowflake, Label: "snowflake"})
+	require.NoError(t, err)
+
+	sourceReaders := artieclient.NewSourceReaderClient(openAPIClient)
+	sourceReader, err := sourceReaders.Create(ctx, openapi.RouterSourceReaderCreateRequest{ConnectorUUID: source.UUID, Name: lib.ToPtr("reader")})
+	require.NoError(t, err)
+
+	salt, err := client.ColumnHashingSalts().Create(ctx, artieclient.BaseColumnHashingSalt{Name: "salt"})
+	require.NoError(t, err)
+	assert.NotEmpty(t, salt.Salt)
+
+	pipelines := client.Pipelines(openAPIClient)
+	pipeline, err := pipelines.Create(ctx, artieclient.BasePipeline{
+		Name:                  "pipeline",
+		SourceReaderUUID:      &sourceReader.Uuid,
+		DestinationUUID:       &destination.UUID,
+		ColumnHashingSaltUUID: &salt.UUID,
+		Tables:                []artieclient.Table{{Name: "account", Schema: "public"}},
+	})
+	require.NoError(t, err)
+	require.Len(t, pipeline.Tables, 1)
+	assert.NotEqual(t, uuid.Nil, pipeline.Tables[0].UUID)
+	// Flush rules that aren't set get Artie's defaults.
+	require.NotNil(t, pipeline.AdvancedSettings)
+	assert.Equal(t, int64(defaultFlushIntervalSeconds), lib.RemovePtr(pipeline.AdvancedSettings.FlushIntervalSeconds))
+	require.NoError(t, pipelines.StartPipeline(ctx, pipeline.UUID.String(), openapi.RouterPipelineStartRequest{}))
+
+	// Table UUIDs are kept across updates, and new tables get one.
+	pipeline.Tables = append(pipeline.Tables, artieclient.Table{Name: "company", Schema: "public"})
+	pipeline.Tables[0].UUID = uuid.Nil
+	updatedPipeline, err := pipelines.Update(ctx, pipeline.Pipeline)
+	require.NoError(t, err)
+	require.Len(t, updatedPipeline.Tables, 2)
+	assert.NotEqual(t, uuid.Nil, updatedPipeline.Tables[1].UUID)
+	fetchedPipeline, err := pipelines.Get(ctx, pipeline.UUID.String())
+	require.NoError(t, err)
+	assert.Equal(t, updatedPipeline, fetchedPipeline)
+	assert.Equal(t, connectors.PostgreSQL, fetchedPipeline.SourceType)
+	assert.Equal(t, "running", *fetchedPipeline.Tables[0].Status)
+
+	// Started pipelines are seen deploying once, and then deployed.
+	assert.True(t, fetchedPipeline.IsDeploying)
+	fetchedPipeline, err = pipelines.Get(ctx, pipeline.UUID.String())
+	require.NoError(t, err)
+	assert.False(t, fetchedPipeline.IsDeploying)
+	assert.False(t, fetchedPipeline.HasUndeployedChanges)
+	assert.NotNil(t, fetchedPipeline.LastDeployedAt)
+
+	// Objects that are in use can't be deleted.
+	for _, err := range []error{
+		client.SSHTunnels().Delete(ctx, sshTunnel.UUID.String()),
+		client.Connectors().Delete(ctx, source.UUID.String()),
+		client.Connectors().Delete(ctx, destination.UUID.String()),
+		sourceReaders.Delete(ctx, sourceReader.Uuid.String()),
+		client.ColumnHashingSalts().Delete(ctx, salt.UUID.String()),
+	} {
+		assert.True(t, errors.As(err, &artieclient.ConflictError{}), err)
+	}
+
+	require.NoError(t, pipelines.Delete(ctx, pipeline.UUID.String()))
+	_, err = pipelines.Get(ctx, pipeline.UUID.String())
+	assert.True(t, errors.As(err, &artieclient.NotFoundError{}), err)
+
+	require.NoError(t, client.ColumnHashingSalts().Delete(ctx, salt.UUID.String()))
+	require.NoError(t, sourceReaders.Delete(ctx, sourceReader.Uuid.String()))
+	require.NoError(t, client.Connectors().Delete(ctx, destination.UUID.String()))
+	require.NoError(t, client.Connectors().Delete(ctx, source.UUID.String()))
+	require.NoError(t, client.SSHTunnels().Delete(ctx, sshTunnel.UUID.String()))
+	assert.Zero(t, server.ObjectCount())
+}
+
+func TestServer_ValidationErrors(t *testing.T) {
+	ctx := t.Context()
+	server := NewServer()
+	defer server.Close()
+	client, openAPIClient := newTestClients(t, server)
+
+	{
+		_, err := client.Connectors().Create(ctx, artieclient.BaseConnector{Type: "not-a-type", Label: "bad"})
+		var validationErr artieclient.ValidationError
+		require.True(t, errors.As(err, &validationErr), err)
+		assert.Contains(t, validationErr.Message, "not-a-type")
+	}
+	{
+		missing := uuid.New()
+		_, err := client.Connectors().Create(ctx, artieclient.BaseConnector{Type: connectors.PostgreSQL, Label: "postgres", SSHTunnelUUID: &missing})
+		assert.True(t, errors.As(err, &artieclient.ValidationError{}), err)
+	}
+	{
+		missing := uuid.New()
+		_, err := client.Connectors().Create(ctx, artieclient.BaseConnector{Type: connectors.PostgreSQL, Label: "postgres", PrivateLinkUUID: &missing})
+		assert.True(t, errors.As(err, &artieclient.ValidationError{}), err)
+	}
+	{
+		_, err := client.SSHTunnels().Create(ctx, artieclient.BaseSSHTunnel{Name: "tunnel", Host: "1.2.3.4", Port: 0, Username: "artie"})
+		assert.True(t, errors.As(err, &artieclient.ValidationError{}), err)
+	}
+	{
+		// a destination can't be used as the source of a source reader
+		destination, err := client.Connectors().Create(ctx, artieclient.BaseConnector{Type: connectors.Snowflake, Label: "snowflake"})
+		require.NoError(t, err)
+		err = artieclient.NewSourceReaderClient(openAPIClient).Validate(ctx, openapi.PayloadsSourceReader{ConnectorUUID: destination.UUID})
+		assert.ErrorContains(t, err, "is not a source connector")
+	}
+	{
+		missing := uuid.New()
+		err := client.Pipelines(openAPIClient).ValidateSource(ctx, artieclient.BasePipeline{SourceReaderUUID: &missing})
+		assert.ErrorContains(t, err, "source validation failed")
+	}
+	{
+		// tables are checked against the source's catalog if it has one
+		source, err := client.Connectors().Create(ctx, artieclient.BaseConnector{Type: connectors.PostgreSQL, Label: "postgres"})
+		require.NoError(t, err)
+		server.SetCatalog(source.UUID, Catalog{"customers": {{Name: lib.ToPtr("account"), Schema: lib.ToPtr("public")}}})
+		sourceReader, err := artieclient.NewSourceReaderClient(openAPIClient).Create(ctx, openapi.RouterSourceReaderCreateRequest{
+			ConnectorUUID: source.UUID,
+			Name:          lib.ToPtr("reader"),
+			Database:      lib.ToPtr("customers"),
+		})
+		require.NoError(t, err)
+		pipelines := client.Pipelines(openAPIClient)
+		require.NoError(t, pipelines.ValidateSource(ctx, artieclient.BasePipeline{SourceReaderUUID: &sourceReader.Uuid, Tables: []artieclient.Table{{Name: "account", Schema: "public"}}}))
+		err = pipelines.ValidateSource(ctx, artieclient.BasePipeline{SourceReaderUUID: &sourceReader.Uuid, Tables: []artieclient.Table{{Name: "acount", Schema: "public"}}})
+		assert.ErrorContains(t, err, `table "public.acount" does not exist in database "customers"`)
+	}
+	{
+		_, err := client.PrivateLinks().Create(ctx, artieclient.BasePrivateLinkConnection{Name: "pl", VpcServiceName: "not-a-service", AzIDs: []string{"use1-az1"}})
+		assert.True(t, errors.As(err, &artieclient.ValidationError{}), err)
+	}
+}
+
+func TestServer_SecretsOnlyReturnedOnCreate(t *testing.T) {
+	ctx := t.Context()
+	server := NewServer()
+	defer server.Close()
+	client, _ := newTestClients(t, server)
+
+	encryptionKey, err := client.EncryptionKeys().Create(ctx, artieclient.BaseEncryptionKey{Name: "key"})
+	require.NoError(t, err)
+	assert.NotEmpty(t, encryptionKey.Key)
+	assert.Equal(t, "passphrase", encryptionKey.Type)
+
+	fetched, err := client.EncryptionKeys().Get(ctx, encryptionKey.UUID.String())
+	require.NoError(t, err)
+	assert.Empty(t, fetched.Key)
+	assert.Equal(t, "key", fetched.Name)
+}
+
+func TestRegionFromServiceName(t *testing.T) {
+	region, ok := regionFromServiceName("com.amazonaws.vpce.us-west-2.vpce-svc-0123456789abcdef0")
+	assert.True(t, ok)
+	assert.Equal(t, "us-west-2", region)
+
+	_, ok = regionFromServiceName("us-west-2")
+	assert.False(t, ok)
+}
@@ -0,0 +1,207 @@
+package artiefake
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+
+	"terraform-provider-artie/internal/lib"
+	"terraform-provider-artie/internal/openapi"
+	"terraform-provider-artie/internal/provider/connectors"
+)
+
+const defaultBackfillBatchSize = 5_000
+
+func (s *Server) registerSourceReaderRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("POST /source-readers", s.createSourceReader)
+	mux.HandleFunc("POST /source-readers/validate-unsaved", s.validateSourceReader)
+	mux.HandleFunc("GET /source-readers/{uuid}", s.getSourceReader)
+	mux.HandleFunc("POST /source-readers/{uuid}", s.updateSourceReader)
+	mux.HandleFunc("DELETE /source-readers/{uuid}", s.deleteSourceReader)
+	mux.HandleFunc("POST /source-readers/{uuid}/deploy", s.deploySourceReader)
+	mux.HandleFunc("POST /source-readers/{uuid}/status", s.updateSourceReaderStatus)
+}
+
+// checkSourceReader returns an error message if sourceReader is invalid. Callers must hold s.mu.
+func (s *Server) checkSourceReader(sourceReader openapi.PayloadsSourceReader) string {
+	connectorUUID := uuid.UUID(sourceReader.ConnectorUUID)
+	if msg := checkReference(&connectorUUID, s.connectors, "connector"); msg != "" {
+		return msg
+	}
+	if !s.isConnectorOfType(&connectorUUID, connectors.SourceTypes()) {
+		return fmt.Sprintf("connector %s is not a source connector", connectorUUID)
+	}
+	if settings := sourceReader.Settings; settings.BackfillBatchSize != nil && *settings.BackfillBatchSize > 50_000 {
+		return "backfill batch size must be at most 50,000"
+	}
+	if lib.RemovePtr(sourceReader.IsShared) && (sourceReader.TablesConfig == nil || len(*sourceReader.TablesConfig) == 0) {
+		return "shared source readers must specify tables"
+	}
+	return ""
+}
+
+func withSourceReaderDefaults(sourceReader openapi.PayloadsSourceReader) openapi.PayloadsSourceReader {
+	if sourceReader.DataPlaneName == "" {
+		sourceReader.DataPlaneName = DefaultDataPlaneName
+	}
+	if sourceReader.IsShared == nil {
+		sourceReader.IsShared = lib.ToPtr(false)
+	}
+	if sourceReader.Settings.BackfillBatchSize == nil {
+		sourceReader.Settings.BackfillBatchSize = lib.ToPtr(defaultBackfillBatchSize)
+	}
+	return sourceReader
+}
+
+func (s *Server) createSourceReader(w http.ResponseWriter, r *http.Request) {
+	body, ok := decodeBody[openapi.RouterSourceReaderCreateRequest](w, r)
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now().UTC()
+	sourceReader := openapi.PayloadsSourceReader{
+		Uuid:          uuid.New(),
+		ConnectorUUID: body.ConnectorUUID,
+		ContainerName: lib.RemovePtr(body.ContainerName),
+		DataPlaneName: lib.RemovePtr(body.DataPlaneName),
+		Database:      lib.RemovePtr(body.Database),
+		IsShared:      body.IsShared,
+		Name:          lib.RemovePtr(body.Name),
+		TablesConfig:  body.TablesConfig,
+		Status:        openapi.EnumsSourceReaderStatusDraft,
+		CreatedAt:     &now,
+		UpdatedAt:     &now,
+	}
+	if body.Settings != nil {
+		sourceReader.Settings = *body.Settings
+	}
+	if msg := s.checkSourceReader(sourceReader); msg != "" {
+		writeError(w, http.StatusBadRequest, "%s", msg)
+		return
+	}
+
+	sourceReader = withSourceReaderDefaults(sourceReader)
+	s.sourceReaders[uuid.UUID(sourceReader.Uuid)] = sourceReader
+	writeJSON(w, http.StatusOK, sourceReader)
+}
+
+func (s *Server) validateSourceReader(w http.ResponseWriter, r *http.Request) {
+	body, ok := decodeBody[openapi.RouterSourceReaderValidateUnsavedRequest](w, r)
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	writeValidation(w, s.checkSourceReader(body.SourceReader))
+}
+
+func (s *Server) getSourceReader(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, sourceReader, ok := lookup(w, r, s.sourceReaders, "source reader"); ok {
+		writeJSON(w, http.StatusOK, sourceReader)
+	}
+}
+
+func (s *Server) updateSourceReader(w http.ResponseWriter, r *http.Request) {
+	body, ok := decodeBody[openapi.PayloadsSourceReader](w, r)
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id, existing, ok := lookup(w, r, s.sourceReaders, "source reader")
+	if !ok {
+		return
+	}
+	if msg := s.checkSourceReader(body); msg != "" {
+		writeError(w, http.StatusBadRequest, "%s", msg)
+		return
+	}
+
+	now := time.Now().UTC()
+	body.Uuid = id
+	body.Status = existing.Status
+	body.CreatedAt = existing.CreatedAt
+	body.UpdatedAt = &now
+	if body.DataPlaneName == "" {
+		body.DataPlaneName = existing.DataPlaneName
+	}
+
+	body = withSourceReaderDefaults(body)
+	s.sourceReaders[id] = body
+	writeJSON(w, http.StatusOK, body)
+}
+
+func (s *Server) deleteSourceReader(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id, _, ok := lookup(w, r, s.sourceReaders, "source reader")
+	if !ok {
+		return
+	}
+	for _, pipeline := range s.pipelines {
+		if pipeline.SourceReaderUUID != nil && *pipeline.SourceReaderUUID == id {
+			writeError(w, http.StatusConflict, "source reader is in use by pipeline %s", pipeline.UUID)
+			return
+		}
+	}
+	for _, ingestionAPIKey := range s.ingestionAPIKeys {
+		if ingestionAPIKey.SourceReaderUUID == id {
+			writeError(w, http.StatusConflict, "source reader is in use by ingestion API key %s", ingestionAPIKey.Uuid)
+			return
+		}
+	}
+
+	delete(s.sourceReaders, id)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) deploySourceReader(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id, sourceReader, ok := lookup(w, r, s.sourceReaders, "source reader")
+	if !ok {
+		return
+	}
+
+	sourceReader.Status = openapi.EnumsSourceReaderStatusRunning
+	s.sourceReaders[id] = sourceReader
+	writeJSON(w, http.StatusOK, map[string]string{})
+}
+
+func (s *Server) updateSourceReaderStatus(w http.ResponseWriter, r *http.Request) {
+	body, ok := decodeBody[openapi.RouterSourceReaderUpdateStatusRequest](w, r)
+	if !ok {
+		return
+	}
+	if !body.Status.Valid() {
+		writeError(w, http.StatusBadRequest, "invalid status %q", body.Status)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id, sourceReader, ok := lookup(w, r, s.sourceReaders, "source reader")
+	if !ok {
+		return
+	}
+
+	sourceReader.Status = body.Status
+	s.sourceReaders[id] = sourceReader
+	writeJSON(w, http.StatusOK, map[string]string{})
+}
@@ -0,0 +1,102 @@
+package artiefake
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"terraform-provider-artie/internal/artieclient"
+)
+
+func (s *Server) registerSSHTunnelRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("POST /ssh-tunnels", s.createSSHTunnel)
+	mux.HandleFunc("GET /ssh-tunnels/{uuid}", s.getSSHTunnel)
+	mux.HandleFunc("POST /ssh-tunnels/{uuid}", s.updateSSHTunnel)
+	mux.HandleFunc("DELETE /ssh-tunnels/{uuid}", s.deleteSSHTunnel)
+}
+
+func validateSSHTunnel(sshTunnel artieclient.BaseSSHTunnel) string {
+	switch {
+	case sshTunnel.Name == "":
+		return "name is required"
+	case sshTunnel.Host == "":
+		return "host is required"
+	case sshTunnel.Port <= 0 || sshTunnel.Port > 65535:
+		return "port must be between 1 and 65535"
+	case sshTunnel.Username == "":
+		return "username is required"
+	}
+	return ""
+}
+
+func (s *Server) createSSHTunnel(w http.ResponseWriter, r *http.Request) {
+	body, ok := decodeBody[artieclient.BaseSSHTunnel](w, r)
+	if !ok {
+		return
+	}
+	if msg := validateSSHTunnel(body); msg != "" {
+		writeError(w, http.StatusBadRequest, "%s", msg)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sshTunnel := artieclient.SSHTunnel{BaseSSHTunnel: body, UUID: uuid.New()}
+	sshTunnel.PublicKey = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAI" + sshTunnel.UUID.String() + " artie"
+	s.sshTunnels[sshTunnel.UUID] = sshTunnel
+	writeJSON(w, http.StatusOK, sshTunnel)
+}
+
+func (s *Server) getSSHTunnel(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, sshTunnel, ok := lookup(w, r, s.sshTunnels, "ssh tunnel"); ok {
+		writeJSON(w, http.StatusOK, sshTunnel)
+	}
+}
+
+func (s *Server) updateSSHTunnel(w http.ResponseWriter, r *http.Request) {
+	body, ok := decodeBody[artieclient.SSHTunnel](w, r)
+	if !ok {
+		return
+	}
+	if msg := validateSSHTunnel(body.BaseSSHTunnel); msg != "" {
+		writeError(w, http.StatusBadRequest, "%s", msg)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id, existing, ok := lookup(w, r, s.sshTunnels, "ssh tunnel")
+	if !ok {
+		return
+	}
+
+	// The key pair is generated by Artie and can't be changed.
+	body.UUID = id
+	body.PublicKey = existing.PublicKey
+	s.sshTunnels[id] = body
+	writeJSON(w, http.StatusOK, body)
+}
+
+func (s *Server) deleteSSHTunnel(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id, _, ok := lookup(w, r, s.sshTunnels, "ssh tunnel")
+	if !ok {
+		return
+	}
+	for _, connector := range s.connectors {
+		if connector.SSHTunnelUUID != nil && *connector.SSHTunnelUUID == id {
+			writeError(w, http.StatusConflict, "ssh tunnel is in use by connector %s", connector.UUID)
+			return
+		}
+	}
+
+	delete(s.sshTunnels, id)
+	w.WriteHeader(http.StatusNoContent)
+}
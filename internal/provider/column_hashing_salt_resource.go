@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"terraform-provider-artie/internal/artieclient"
@@ -14,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var _ resource.Resource = &ColumnHashingSaltResource{}
@@ -121,6 +123,11 @@ func (r *ColumnHashingSaltResource) Read(ctx context.Context, req resource.ReadR
 
 	salt, err := r.client.ColumnHashingSalts().Get(ctx, stateData.UUID.ValueString())
 	if err != nil {
+		if errors.As(err, &artieclient.NotFoundError{}) {
+			tflog.Warn(ctx, fmt.Sprintf("Column Hashing Salt %s no longer exists, removing it from state", stateData.UUID.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError("Unable to read Column Hashing Salt", err.Error())
 		return
 	}
@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestColumnHashingSaltResource_ReadNotFound(t *testing.T) {
+	testReadRemovesMissingResource(t, NewColumnHashingSaltResource)
+}
+
+func testAccColumnHashingSaltConfig(name string) string {
+	return fmt.Sprintf(`
+resource "artie_column_hashing_salt" "test" {
+  name        = %q
+  description = "Salt for hashed emails"
+}
+`, name)
+}
+
+func TestAccColumnHashingSaltResource(t *testing.T) {
+	server := newTestAccServer(t)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(server),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + testAccColumnHashingSaltConfig("Salt"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("artie_column_hashing_salt.test", "name", "Salt"),
+					resource.TestCheckResourceAttrSet("artie_column_hashing_salt.test", "salt"),
+				),
+			},
+			{
+				Config: testAccProviderConfig(server) + testAccColumnHashingSaltConfig("Renamed Salt"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("artie_column_hashing_salt.test", "name", "Renamed Salt"),
+					resource.TestCheckResourceAttrSet("artie_column_hashing_salt.test", "salt"),
+				),
+			},
+			{
+				ResourceName:                         "artie_column_hashing_salt.test",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "uuid",
+				ImportStateVerifyIgnore:              []string{"salt"},
+				ImportStateIdFunc:                    testAccImportStateUUID("artie_column_hashing_salt.test"),
+			},
+		},
+	})
+}
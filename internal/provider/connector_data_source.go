@@ -0,0 +1,186 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"terraform-provider-artie/internal/artieclient"
+	"terraform-provider-artie/internal/provider/connectors"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ConnectorDataSource{}
+var _ datasource.DataSourceWithConfigure = &ConnectorDataSource{}
+
+func NewConnectorDataSource() datasource.DataSource {
+	return &ConnectorDataSource{}
+}
+
+type ConnectorDataSource struct {
+	client artieclient.Client
+}
+
+// connectorResourceSchema returns the schema of the artie_connector resource, which the data source's schema and
+// state are derived from.
+func connectorResourceSchema(ctx context.Context) schema.Schema {
+	var resp resource.SchemaResponse
+	(&ConnectorResource{}).Schema(ctx, resource.SchemaRequest{}, &resp)
+	return resp.Schema
+}
+
+// isDataSourceAttribute returns whether a resource attribute is exposed by the data source. Secrets, the versions of
+// their write-only variants, and settings that only affect how Terraform manages the connector are left out.
+func isDataSourceAttribute(name string, attribute schema.Attribute) bool {
+	return !attribute.IsSensitive() && !strings.HasSuffix(name, connectors.WriteOnlyVersionSuffix) && name != "test_connection_on_plan"
+}
+
+// dataSourceAttributes converts resource attributes into computed data source attributes, leaving out secrets. An error
+// is added to diagnostics for any attribute whose type can't be converted.
+func dataSourceAttributes(attributes map[string]schema.Attribute, parent path.Path, diagnostics *diag.Diagnostics) map[string]dsschema.Attribute {
+	dsAttributes := map[string]dsschema.Attribute{}
+	for name, attribute := range attributes {
+		if !isDataSourceAttribute(name, attribute) {
+			continue
+		}
+
+		description := attribute.GetMarkdownDescription()
+		switch attribute := attribute.(type) {
+		case schema.StringAttribute:
+			dsAttributes[name] = dsschema.StringAttribute{Computed: true, MarkdownDescription: description}
+		case schema.Int32Attribute:
+			dsAttributes[name] = dsschema.Int32Attribute{Computed: true, MarkdownDescription: description}
+		case schema.Int64Attribute:
+			dsAttributes[name] = dsschema.Int64Attribute{Computed: true, MarkdownDescription: description}
+		case schema.BoolAttribute:
+			dsAttributes[name] = dsschema.BoolAttribute{Computed: true, MarkdownDescription: description}
+		case schema.ListAttribute:
+			dsAttributes[name] = dsschema.ListAttribute{Computed: true, ElementType: attribute.ElementType, MarkdownDescription: description}
+		case schema.SingleNestedAttribute:
+			dsAttributes[name] = dsschema.SingleNestedAttribute{
+				Computed:            true,
+				Attributes:          dataSourceAttributes(attribute.Attributes, parent.AtName(name), diagnostics),
+				MarkdownDescription: description,
+			}
+		default:
+			diagnostics.AddAttributeError(parent.AtName(name), "Unsupported connector attribute", fmt.Sprintf("Attributes of type %T can't be exposed by the connector data source. Please report this issue to the provider developers.", attribute))
+		}
+	}
+	return dsAttributes
+}
+
+// copyNonSensitiveAttributes copies the value of every attribute that the data source exposes from src to dst.
+func copyNonSensitiveAttributes(ctx context.Context, src tfsdk.State, dst *tfsdk.State, attributes map[string]schema.Attribute, parent path.Path, diagnostics *diag.Diagnostics) {
+	for name, attribute := range attributes {
+		if !isDataSourceAttribute(name, attribute) {
+			continue
+		}
+
+		attrPath := parent.AtName(name)
+		var value attr.Value
+		diagnostics.Append(src.GetAttribute(ctx, attrPath, &value)...)
+		if diagnostics.HasError() {
+			return
+		}
+
+		if nested, ok := attribute.(schema.SingleNestedAttribute); ok {
+			if !value.IsNull() {
+				copyNonSensitiveAttributes(ctx, src, dst, nested.Attributes, attrPath, diagnostics)
+			}
+			continue
+		}
+		diagnostics.Append(dst.SetAttribute(ctx, attrPath, value)...)
+	}
+}
+
+func (d *ConnectorDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_connector"
+}
+
+func (d *ConnectorDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := dataSourceAttributes(connectorResourceSchema(ctx).Attributes, path.Empty(), &resp.Diagnostics)
+	for name, attribute := range attributes {
+		// The resource's descriptions of its config blocks are about filling them out, so replace them.
+		if nested, ok := attribute.(dsschema.SingleNestedAttribute); ok {
+			nested.MarkdownDescription = fmt.Sprintf("The connector's settings, if its type is `%s`.", strings.TrimSuffix(name, "_config"))
+			attributes[name] = nested
+		}
+	}
+	// Connectors can only be looked up by UUID. Looking them up by name and type would need an endpoint that lists
+	// connectors, and the API doesn't have one.
+	attributes["uuid"] = dsschema.StringAttribute{
+		Required:            true,
+		MarkdownDescription: "The UUID of the connector to look up.",
+	}
+
+	resp.Schema = dsschema.Schema{
+		MarkdownDescription: "Artie Connector data source. Use this to look up an existing connector by `uuid`, so that it can be referenced by resources that aren't managed in the same workspace. The Artie API can't list connectors, so they can't be looked up by name. Sensitive settings such as passwords are not exposed.",
+		Attributes:          attributes,
+	}
+}
+
+func (d *ConnectorDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(ArtieProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected ArtieProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	client, err := providerData.NewClient()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to build Artie client", err.Error())
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ConnectorDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var uuid types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("uuid"), &uuid)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	details, err := d.client.Connectors().Get(ctx, uuid.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Read Connector", err.Error())
+		return
+	}
+
+	connector, diags := connectors.ConnectorFromAPIModel(details)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Build the resource's state and copy everything that isn't sensitive, so that the data source stays in sync with
+	// the resource as connector types and settings are added.
+	resourceSchema := connectorResourceSchema(ctx)
+	resourceState := tfsdk.State{Schema: resourceSchema, Raw: tftypes.NewValue(resourceSchema.Type().TerraformType(ctx), nil)}
+	resp.Diagnostics.Append(connectors.Set(ctx, &resourceState, connector)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	copyNonSensitiveAttributes(ctx, resourceState, &resp.State, resourceSchema.Attributes, path.Empty(), &resp.Diagnostics)
+}
@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"terraform-provider-artie/internal/artieclient"
+	"terraform-provider-artie/internal/provider/connectors"
+)
+
+func TestConnectorDataSource_Schema(t *testing.T) {
+	// Every attribute of artie_connector has to be convertible, or the data source's schema is broken.
+	var resp datasource.SchemaResponse
+	NewConnectorDataSource().Schema(t.Context(), datasource.SchemaRequest{}, &resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	assert.Contains(t, resp.Schema.Attributes, "postgresql_config")
+	assert.NotContains(t, resp.Schema.Attributes, "test_connection_on_plan")
+}
+
+func TestConnectorDataSource_Read(t *testing.T) {
+	ctx := t.Context()
+	server := newTestAccServer(t)
+	client, err := newTestProviderData(server.URL).NewClient()
+	require.NoError(t, err)
+	created, err := client.Connectors().Create(ctx, artieclient.BaseConnector{
+		Type:   connectors.PostgreSQL,
+		Label:  "orders",
+		Config: artieclient.ConnectorConfig{"host": "db.example.com", "port": 5432, "user": "artie", "password": "hunter2"},
+	})
+	require.NoError(t, err)
+
+	{
+		d := NewConnectorDataSource()
+		configureTestDataSource(t, d.(*ConnectorDataSource), server.URL)
+		resp := readTestDataSource(t, ctx, d, map[string]any{"uuid": created.UUID.String()})
+		require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+		var uuidValue, host, createdAt types.String
+		var isValid types.Bool
+		resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("uuid"), &uuidValue)...)
+		resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("postgresql_config").AtName("host"), &host)...)
+		resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("is_valid"), &isValid)...)
+		resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("created_at"), &createdAt)...)
+		require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+		assert.Equal(t, created.UUID.String(), uuidValue.ValueString())
+		assert.Equal(t, "db.example.com", host.ValueString())
+		assert.True(t, isValid.ValueBool())
+		assert.NotEmpty(t, createdAt.ValueString())
+
+		// Secrets are never exposed by the data source.
+		var password types.String
+		diags := resp.State.GetAttribute(ctx, path.Root("postgresql_config").AtName("password"), &password)
+		assert.True(t, diags.HasError())
+	}
+	{
+		d := NewConnectorDataSource()
+		configureTestDataSource(t, d.(*ConnectorDataSource), server.URL)
+		resp := readTestDataSource(t, ctx, d, map[string]any{"uuid": uuid.NewString()})
+		require.True(t, resp.Diagnostics.HasError())
+		assert.Equal(t, "Unable to Read Connector", resp.Diagnostics.Errors()[0].Summary())
+	}
+}
+
+func TestAccConnectorDataSource(t *testing.T) {
+	server := newTestAccServer(t)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(server),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + `
+resource "artie_connector" "postgres" {
+  name = "Orders"
+  type = "postgresql"
+  postgresql_config = {
+    host     = "db.example.com"
+    port     = 5432
+    username = "artie"
+    password = "hunter2"
+  }
+}
+
+data "artie_connector" "by_uuid" {
+  uuid = artie_connector.postgres.uuid
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.artie_connector.by_uuid", "postgresql_config.host", "artie_connector.postgres", "postgresql_config.host"),
+					resource.TestCheckResourceAttr("data.artie_connector.by_uuid", "is_valid", "true"),
+					resource.TestCheckResourceAttrSet("data.artie_connector.by_uuid", "created_at"),
+					resource.TestCheckNoResourceAttr("data.artie_connector.by_uuid", "postgresql_config.password"),
+				),
+			},
+		},
+	})
+}
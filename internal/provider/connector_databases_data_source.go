@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-artie/internal/artieclient"
+	"terraform-provider-artie/internal/provider/tfmodels"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ConnectorDatabasesDataSource{}
+var _ datasource.DataSourceWithConfigure = &ConnectorDatabasesDataSource{}
+
+func NewConnectorDatabasesDataSource() datasource.DataSource {
+	return &ConnectorDatabasesDataSource{}
+}
+
+type ConnectorDatabasesDataSource struct {
+	catalog artieclient.ConnectorCatalogClient
+}
+
+func (d *ConnectorDatabasesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_connector_databases"
+}
+
+func (d *ConnectorDatabasesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Artie Connector Databases data source. This lists the databases that a connector can see.",
+		Attributes: map[string]schema.Attribute{
+			"connector_uuid": schema.StringAttribute{Required: true, MarkdownDescription: "The UUID of the connector to list databases for."},
+			"databases":      schema.ListAttribute{Computed: true, ElementType: types.StringType, MarkdownDescription: "The names of the databases that the connector can see."},
+		},
+	}
+}
+
+func (d *ConnectorDatabasesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(ArtieProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected ArtieProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData))
+		return
+	}
+
+	openAPIClient, err := providerData.NewOpenAPIClient()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to build Artie client", err.Error())
+		return
+	}
+
+	d.catalog = artieclient.NewConnectorCatalogClient(openAPIClient)
+}
+
+func (d *ConnectorDatabasesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var configData tfmodels.ConnectorDatabasesDataSource
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	databases, err := d.catalog.Databases(ctx, configData.ConnectorUUID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to List Connector Databases", err.Error())
+		return
+	}
+
+	databasesList, diags := types.ListValueFrom(ctx, types.StringType, databases)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	configData.Databases = databasesList
+	resp.Diagnostics.Append(resp.State.Set(ctx, configData)...)
+}
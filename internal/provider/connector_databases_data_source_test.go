@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"terraform-provider-artie/internal/provider/tfmodels"
+)
+
+func TestConnectorDatabasesDataSource_Read(t *testing.T) {
+	ctx := t.Context()
+	server := newTestAccServer(t)
+	connector := createTestCatalogConnector(t, server)
+
+	{
+		d := NewConnectorDatabasesDataSource()
+		configureTestDataSource(t, d.(*ConnectorDatabasesDataSource), server.URL)
+		resp := readTestDataSource(t, ctx, d, map[string]any{"connector_uuid": connector.UUID.String()})
+		require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+		var state tfmodels.ConnectorDatabasesDataSource
+		resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+		require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+		var databases []string
+		resp.Diagnostics.Append(state.Databases.ElementsAs(ctx, &databases, false)...)
+		require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+		assert.Equal(t, []string{"orders", "postgres"}, databases)
+	}
+	{
+		d := NewConnectorDatabasesDataSource()
+		configureTestDataSource(t, d.(*ConnectorDatabasesDataSource), server.URL)
+		resp := readTestDataSource(t, ctx, d, map[string]any{"connector_uuid": uuid.NewString()})
+		require.True(t, resp.Diagnostics.HasError())
+		assert.Equal(t, "Unable to List Connector Databases", resp.Diagnostics.Errors()[0].Summary())
+	}
+}
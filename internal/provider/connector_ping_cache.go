@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"sync"
+
+	"terraform-provider-artie/internal/artieclient"
+)
+
+// connectorPingCache remembers the result of testing each connector configuration, so that a connector is only pinged
+// once per plan even if several resources have the same settings or Terraform plans a resource more than once. A new
+// cache is made each time the provider is configured.
+type connectorPingCache struct {
+	mu      sync.Mutex
+	results map[[sha256.Size]byte]error
+}
+
+func newConnectorPingCache() *connectorPingCache {
+	return &connectorPingCache{results: map[[sha256.Size]byte]error{}}
+}
+
+// TestConnection tests the connection to connector, unless a connector with the same settings has already been tested.
+func (c *connectorPingCache) TestConnection(ctx context.Context, client artieclient.Client, connector artieclient.BaseConnector) error {
+	// The label isn't sent when testing connections. Secrets are only kept in the cache as part of a hash.
+	connector.Label = ""
+	body, err := json.Marshal(connector)
+	if err != nil {
+		return err
+	}
+	key := sha256.Sum256(body)
+
+	c.mu.Lock()
+	err, ok := c.results[key]
+	c.mu.Unlock()
+	if ok {
+		return err
+	}
+
+	// The lock isn't held while pinging so that different connectors can be tested in parallel.
+	err = client.Connectors().TestConnection(ctx, connector)
+	c.mu.Lock()
+	c.results[key] = err
+	c.mu.Unlock()
+	return err
+}
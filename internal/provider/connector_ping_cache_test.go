@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"terraform-provider-artie/internal/artieclient"
+	"terraform-provider-artie/internal/provider/connectors"
+)
+
+func TestConnectorPingCache(t *testing.T) {
+	pings := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		pings++
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"error": "password authentication failed"}`))
+	}))
+	t.Cleanup(server.Close)
+	client, err := newTestProviderData(server.URL).NewClient()
+	require.NoError(t, err)
+
+	cache := newConnectorPingCache()
+	connector := artieclient.BaseConnector{Type: connectors.PostgreSQL, Label: "Postgres", Config: artieclient.ConnectorConfig{"password": "hunter2"}}
+	{
+		err := cache.TestConnection(t.Context(), client, connector)
+		assert.ErrorContains(t, err, "password authentication failed")
+		assert.Equal(t, 1, pings)
+	}
+	{
+		// Connectors that only differ in their label are the same as far as testing them is concerned.
+		renamed := connector
+		renamed.Label = "Renamed"
+		err := cache.TestConnection(t.Context(), client, renamed)
+		assert.ErrorContains(t, err, "password authentication failed")
+		assert.Equal(t, 1, pings)
+	}
+	{
+		changed := connector
+		changed.Config = artieclient.ConnectorConfig{"password": "hunter3"}
+		assert.Error(t, cache.TestConnection(t.Context(), client, changed))
+		assert.Equal(t, 2, pings)
+	}
+}
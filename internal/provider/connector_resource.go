@@ -2,24 +2,24 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"math"
 	"strings"
 
-	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
-	"terraform-provider-artie/internal/maputil"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"terraform-provider-artie/internal/artieclient"
+	"terraform-provider-artie/internal/provider/connectors"
 	"terraform-provider-artie/internal/provider/tfmodels"
 )
 
@@ -27,13 +27,16 @@ import (
 var _ resource.Resource = &ConnectorResource{}
 var _ resource.ResourceWithConfigure = &ConnectorResource{}
 var _ resource.ResourceWithImportState = &ConnectorResource{}
+var _ resource.ResourceWithModifyPlan = &ConnectorResource{}
 
 func NewConnectorResource() resource.Resource {
 	return &ConnectorResource{}
 }
 
 type ConnectorResource struct {
-	client artieclient.Client
+	client                artieclient.Client
+	testConnectionsOnPlan bool
+	connectorPings        *connectorPingCache
 }
 
 func (r *ConnectorResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -41,9 +44,9 @@ func (r *ConnectorResource) Metadata(ctx context.Context, req resource.MetadataR
 }
 
 func (r *ConnectorResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
-	connectorTypes := maputil.NewSortedStringsMap[bool]()
-	for _, connectorType := range artieclient.AllConnectorTypes {
-		connectorTypes.Add(fmt.Sprintf("`%s`", connectorType), true)
+	var connectorTypes []string
+	for _, connectorType := range connectors.Types() {
+		connectorTypes = append(connectorTypes, fmt.Sprintf("`%s`", connectorType))
 	}
 
 	resp.Schema = schema.Schema{
@@ -51,10 +54,22 @@ func (r *ConnectorResource) Schema(ctx context.Context, req resource.SchemaReque
 		Attributes: map[string]schema.Attribute{
 			"uuid":            schema.StringAttribute{Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}},
 			"ssh_tunnel_uuid": schema.StringAttribute{Computed: true, Optional: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}, MarkdownDescription: "This can point to an `artie_ssh_tunnel` resource if you need us to use an SSH tunnel to connect."},
+			"private_link_uuid": schema.StringAttribute{
+				Computed:            true,
+				Optional:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				MarkdownDescription: "This can point to an `artie_private_link` resource if you need us to connect over AWS PrivateLink. This cannot be used together with `ssh_tunnel_uuid`.",
+			},
+			"snapshot_private_link_uuid": schema.StringAttribute{
+				Computed:            true,
+				Optional:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				MarkdownDescription: "This can point to an `artie_private_link` resource that we should use for backfills instead of `private_link_uuid`, e.g. if backfills read from a replica. This cannot be used together with `ssh_tunnel_uuid`.",
+			},
 			"type": schema.StringAttribute{
 				Required:            true,
-				MarkdownDescription: fmt.Sprintf("The type of connector. This must be one of the following: %s.", strings.Join(connectorTypes.Keys(), ", ")),
-				Validators:          []validator.String{stringvalidator.OneOf(artieclient.AllConnectorTypes...)},
+				MarkdownDescription: fmt.Sprintf("The type of connector. This must be one of the following: %s.", strings.Join(connectorTypes, ", ")),
+				Validators:          []validator.String{stringvalidator.OneOf(connectors.Types()...)},
 			},
 			"name": schema.StringAttribute{Optional: true, MarkdownDescription: "An optional human-readable label for this connector."},
 			"data_plane_name": schema.StringAttribute{
@@ -63,226 +78,42 @@ func (r *ConnectorResource) Schema(ctx context.Context, req resource.SchemaReque
 				Computed:            true,
 				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
-			"bigquery_config": schema.SingleNestedAttribute{
-				MarkdownDescription: "This should be filled out if the connector type is `bigquery`.",
-				Optional:            true,
-				Attributes: map[string]schema.Attribute{
-					"project_id":       schema.StringAttribute{Required: true, MarkdownDescription: "The ID of the Google Cloud project."},
-					"location":         schema.StringAttribute{Required: true, MarkdownDescription: "The location of the BigQuery dataset. This must be either `US` or `EU`."},
-					"credentials_data": schema.StringAttribute{Required: true, Sensitive: true, MarkdownDescription: "The credentials data for the Google Cloud service account that we should use to connect to BigQuery. We recommend storing this in a secret manager and referencing it via a *sensitive* Terraform variable, instead of putting it in plaintext in your Terraform config file."},
-				},
-			},
-			"cockroach_config": schema.SingleNestedAttribute{
-				Optional:            true,
-				MarkdownDescription: "This should be filled out if the connector type is `cockroach`.",
-				Attributes: map[string]schema.Attribute{
-					"host":          schema.StringAttribute{Required: true, MarkdownDescription: "The hostname of the CockroachDB database."},
-					"snapshot_host": schema.StringAttribute{Optional: true, Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}, MarkdownDescription: "The hostname of the CockroachDB database that we should use to snapshot the database. This can be a read replica and will only be used if this connector is being used as a source. If not provided, we will use the `host` value."},
-					"snapshot_port": schema.Int32Attribute{Optional: true, Computed: true, MarkdownDescription: "The port of the CockroachDB database that we should use to snapshot the database. If not provided, we will use the `port` value."},
-					"port": schema.Int32Attribute{
-						Required:            true,
-						MarkdownDescription: "The default port for CockroachDB is 26257.",
-						Validators: []validator.Int32{
-							int32validator.Between(1024, math.MaxUint16),
-						},
-					},
-					"username": schema.StringAttribute{Required: true, MarkdownDescription: "The username of the service account we will use to connect to the CockroachDB database."},
-					"password": schema.StringAttribute{Required: true, Sensitive: true, MarkdownDescription: "The password of the service account. We recommend storing this in a secret manager and referencing it via a *sensitive* Terraform variable, instead of putting it in plaintext in your Terraform config file."},
-				},
-			},
-			"dynamodb_config": schema.SingleNestedAttribute{
-				Optional:            true,
-				MarkdownDescription: "This should be filled out if the connector type is `dynamodb`. You must provide either `role_arn` (for IAM role assumption) or both `access_key_id` and `secret_access_key` (for static credentials).",
-				Attributes: map[string]schema.Attribute{
-					"stream_arn":        schema.StringAttribute{Required: true, MarkdownDescription: "The ARN (Amazon Resource Name) of the DynamoDB Stream."},
-					"access_key_id":     schema.StringAttribute{Optional: true, Computed: true, Default: stringdefault.StaticString(""), MarkdownDescription: "The AWS Access Key ID for the service account we should use to connect to DynamoDB. Required if `role_arn` is not set."},
-					"secret_access_key": schema.StringAttribute{Optional: true, Computed: true, Sensitive: true, Default: stringdefault.StaticString(""), MarkdownDescription: "The AWS Secret Access Key for the service account we should use to connect to DynamoDB. Required if `role_arn` is not set. We recommend storing this in a secret manager and referencing it via a *sensitive* Terraform variable, instead of putting it in plaintext in your Terraform config file."},
-					"role_arn":          schema.StringAttribute{Optional: true, Computed: true, Default: stringdefault.StaticString(""), MarkdownDescription: "The ARN of the IAM role to assume for connecting to DynamoDB. If set, `access_key_id` and `secret_access_key` are not required."},
-					"external_id":       schema.StringAttribute{Optional: true, Computed: true, Default: stringdefault.StaticString(""), MarkdownDescription: "The external ID to use when assuming the IAM role. Only applicable when `role_arn` is set."},
-				},
-			},
-			"gcs_config": schema.SingleNestedAttribute{
-				MarkdownDescription: "This should be filled out if the connector type is `gcs`.",
-				Optional:            true,
-				Attributes: map[string]schema.Attribute{
-					"project_id":       schema.StringAttribute{Required: true, MarkdownDescription: "The ID of the Google Cloud project."},
-					"credentials_data": schema.StringAttribute{Required: true, Sensitive: true, MarkdownDescription: "The credentials data for the Google Cloud service account that we should use to connect to GCS. We recommend storing this in a secret manager and referencing it via a *sensitive* Terraform variable, instead of putting it in plaintext in your Terraform config file."},
-				},
-			},
-			"keyspaces_config": schema.SingleNestedAttribute{
+			"default_database": schema.StringAttribute{
 				Optional:            true,
-				MarkdownDescription: "This should be filled out if the connector type is `keyspaces`. You must provide either `role_arn` (for IAM role assumption) or both `access_key_id` and `secret_access_key` (for static credentials).",
-				Attributes: map[string]schema.Attribute{
-					"host": schema.StringAttribute{Required: true, MarkdownDescription: "The hostname of the Amazon Keyspaces endpoint."},
-					"port": schema.Int32Attribute{
-						Required:            true,
-						MarkdownDescription: "The default port for Amazon Keyspaces is 9142.",
-						Validators: []validator.Int32{
-							int32validator.Between(1024, math.MaxUint16),
-						},
-					},
-					"region":            schema.StringAttribute{Required: true, MarkdownDescription: "The AWS region of the Amazon Keyspaces instance."},
-					"access_key_id":     schema.StringAttribute{Optional: true, Computed: true, Default: stringdefault.StaticString(""), MarkdownDescription: "The AWS Access Key ID for connecting to Amazon Keyspaces. Required if `role_arn` is not set."},
-					"secret_access_key": schema.StringAttribute{Optional: true, Computed: true, Sensitive: true, Default: stringdefault.StaticString(""), MarkdownDescription: "The AWS Secret Access Key for connecting to Amazon Keyspaces. Required if `role_arn` is not set. We recommend storing this in a secret manager and referencing it via a *sensitive* Terraform variable, instead of putting it in plaintext in your Terraform config file."},
-					"role_arn":          schema.StringAttribute{Optional: true, Computed: true, Default: stringdefault.StaticString(""), MarkdownDescription: "The ARN of the IAM role to assume for connecting to Amazon Keyspaces. If set, `access_key_id` and `secret_access_key` are not required."},
-					"external_id":       schema.StringAttribute{Optional: true, Computed: true, Default: stringdefault.StaticString(""), MarkdownDescription: "The external ID to use when assuming the IAM role. Only applicable when `role_arn` is set."},
-				},
-			},
-			"iceberg_config": schema.SingleNestedAttribute{
-				MarkdownDescription: "This should be filled out if the connector type is `iceberg`. The `provider` field determines which additional fields are required: for `s3tables`, provide AWS credentials and bucket ARN; for `rest`, provide the catalog URI, warehouse, and authentication credentials.",
-				Optional:            true,
-				Attributes: map[string]schema.Attribute{
-					"provider": schema.StringAttribute{Required: true, MarkdownDescription: "The Iceberg provider type. Must be `s3tables` or `rest`.", Validators: []validator.String{stringvalidator.OneOf("s3tables", "rest")}},
-
-					// S3 Tables fields:
-					"access_key_id":     schema.StringAttribute{Optional: true, Computed: true, Default: stringdefault.StaticString(""), MarkdownDescription: "The AWS Access Key ID for connecting to S3 Tables. Required if `provider` is `s3tables`."},
-					"secret_access_key": schema.StringAttribute{Optional: true, Computed: true, Sensitive: true, Default: stringdefault.StaticString(""), MarkdownDescription: "The AWS Secret Access Key for connecting to S3 Tables. Required if `provider` is `s3tables`. We recommend storing this in a secret manager and referencing it via a *sensitive* Terraform variable."},
-					"bucket_arn":        schema.StringAttribute{Optional: true, Computed: true, Default: stringdefault.StaticString(""), MarkdownDescription: "The ARN of the S3 Tables table bucket (e.g. `arn:aws:s3tables:us-east-1:123456789012:bucket/my-bucket`). Required if `provider` is `s3tables`."},
-					"region":            schema.StringAttribute{Optional: true, Computed: true, Default: stringdefault.StaticString(""), MarkdownDescription: "The AWS region for S3 Tables. Optional; can be parsed from the `bucket_arn`."},
-
-					// REST Catalog fields:
-					"uri":        schema.StringAttribute{Optional: true, Computed: true, Default: stringdefault.StaticString(""), MarkdownDescription: "The REST catalog endpoint URL. Required if `provider` is `rest`."},
-					"token":      schema.StringAttribute{Optional: true, Computed: true, Sensitive: true, Default: stringdefault.StaticString(""), MarkdownDescription: "A bearer token for authenticating with the REST catalog. Either `token` or `credential` must be provided if `provider` is `rest`."},
-					"credential": schema.StringAttribute{Optional: true, Computed: true, Sensitive: true, Default: stringdefault.StaticString(""), MarkdownDescription: "OAuth2 client credentials in the format `client_id:client_secret` for authenticating with the REST catalog. Either `token` or `credential` must be provided if `provider` is `rest`."},
-					"auth_uri":   schema.StringAttribute{Optional: true, Computed: true, Default: stringdefault.StaticString(""), MarkdownDescription: "The OAuth2 token endpoint URL. Required when using `credential` authentication (without `token`)."},
-					"scope":      schema.StringAttribute{Optional: true, Computed: true, Default: stringdefault.StaticString(""), MarkdownDescription: "The OAuth2 scope. Optional; defaults to `catalog` on the server side."},
-					"warehouse":  schema.StringAttribute{Optional: true, Computed: true, Default: stringdefault.StaticString(""), MarkdownDescription: "The warehouse identifier for the REST catalog. Required if `provider` is `rest`."},
-					"prefix":     schema.StringAttribute{Optional: true, Computed: true, Default: stringdefault.StaticString(""), MarkdownDescription: "An optional catalog prefix for namespacing in the REST catalog."},
-				},
-			},
-			"mongodb_config": schema.SingleNestedAttribute{
-				Optional:            true,
-				MarkdownDescription: "This should be filled out if the connector type is `mongodb`.",
-				Attributes: map[string]schema.Attribute{
-					"host":     schema.StringAttribute{Required: true, MarkdownDescription: "The connection string for the MongoDB server. This can be either SRV or standard format."},
-					"username": schema.StringAttribute{Required: true, MarkdownDescription: "The username of the service account we will use to connect to the MongoDB database."},
-					"password": schema.StringAttribute{Required: true, Sensitive: true, MarkdownDescription: "The password of the service account we will use to connect to the MongoDB database. We recommend storing this in a secret manager and referencing it via a *sensitive* Terraform variable, instead of putting it in plaintext in your Terraform config file."},
-				},
-			},
-			"mysql_config": schema.SingleNestedAttribute{
-				Optional:            true,
-				MarkdownDescription: "This should be filled out if the connector type is `mysql`.",
-				Attributes: map[string]schema.Attribute{
-					"host":          schema.StringAttribute{Required: true, MarkdownDescription: "The hostname of the MySQL database. This must point to the primary host, not a read replica."},
-					"snapshot_host": schema.StringAttribute{Optional: true, Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}, MarkdownDescription: "The hostname of the MySQL database that we should use to snapshot the database. This can be a read replica and will only be used if this connector is being used as a source. If not provided, we will use the `host` value."},
-					"snapshot_port": schema.Int32Attribute{Optional: true, Computed: true, MarkdownDescription: "The port of the MySQL database that we should use to snapshot the database. If not provided, we will use the `port` value."},
-					"port": schema.Int32Attribute{
-						Required:            true,
-						MarkdownDescription: "The default port for MySQL is 3306.",
-						Validators: []validator.Int32{
-							int32validator.Between(1024, math.MaxUint16),
-						},
-					},
-					"username": schema.StringAttribute{Required: true, MarkdownDescription: "The username of the service account we will use to connect to the MySQL database. This service account needs enough permissions to read from the server binlogs."},
-					"password": schema.StringAttribute{Required: true, Sensitive: true, MarkdownDescription: "The password of the service account. We recommend storing this in a secret manager and referencing it via a *sensitive* Terraform variable, instead of putting it in plaintext in your Terraform config file."},
-					"tls_mode": schema.StringAttribute{
-						Optional:            true,
-						Computed:            true,
-						Default:             stringdefault.StaticString(""),
-						MarkdownDescription: "The TLS mode for the MySQL connection. Use `\"\"` (empty string) to disable TLS, or `\"preferred\"` to enable TLS preferred mode.",
-						Validators: []validator.String{
-							stringvalidator.OneOf("", "preferred"),
-						},
-					},
-				},
-			},
-			"mssql_config": schema.SingleNestedAttribute{
-				Optional:            true,
-				MarkdownDescription: "This should be filled out if the connector type is `mssql`.",
-				Attributes: map[string]schema.Attribute{
-					"host":          schema.StringAttribute{Required: true, MarkdownDescription: "The hostname of the Microsoft SQL Server. This must point to the primary host, not a read replica."},
-					"snapshot_host": schema.StringAttribute{Optional: true, Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}, MarkdownDescription: "The hostname of the Microsoft SQL Server that we should use to snapshot the database. This can be a read replica and will only be used if this connector is being used as a source. If not provided, we will use the `host` value."},
-					"port": schema.Int32Attribute{
-						Required:            true,
-						MarkdownDescription: "The default port for Microsoft SQL Server is 1433.",
-						Validators: []validator.Int32{
-							int32validator.Between(1024, math.MaxUint16),
-						},
-					},
-					"username": schema.StringAttribute{Required: true, MarkdownDescription: "The username of the service account we will use to connect to the database."},
-					"password": schema.StringAttribute{Required: true, Sensitive: true, MarkdownDescription: "The password of the service account. We recommend storing this in a secret manager and referencing it via a *sensitive* Terraform variable, instead of putting it in plaintext in your Terraform config file."},
-				},
-			},
-			"oracle_config": schema.SingleNestedAttribute{
-				Optional:            true,
-				MarkdownDescription: "This should be filled out if the connector type is `oracle`.",
-				Attributes: map[string]schema.Attribute{
-					"host":          schema.StringAttribute{Required: true, MarkdownDescription: "The hostname of the Oracle database. This must point to the primary host, not a read replica. This database must also have `ARCHIVELOG` mode and supplemental logging enabled."},
-					"snapshot_host": schema.StringAttribute{Optional: true, Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}, MarkdownDescription: "The hostname of the Oracle database that we should use to snapshot the database. This can be a read replica and will only be used if this connector is being used as a source. If not provided, we will use the `host` value."},
-					"port": schema.Int32Attribute{
-						Required:            true,
-						MarkdownDescription: "The default port for Oracle is 1521.",
-						Validators: []validator.Int32{
-							int32validator.Between(1024, math.MaxUint16),
-						},
-					},
-					"username": schema.StringAttribute{Required: true, MarkdownDescription: "The username of the service account we will use to connect to the Oracle database."},
-					"password": schema.StringAttribute{Required: true, Sensitive: true, MarkdownDescription: "The password of the service account. We recommend storing this in a secret manager and referencing it via a *sensitive* Terraform variable, instead of putting it in plaintext in your Terraform config file."},
-				},
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				MarkdownDescription: "The database that Artie should use by default when browsing this connector's tables, for connector types that have more than one database.",
 			},
-			"postgresql_config": schema.SingleNestedAttribute{
-				Optional:            true,
-				MarkdownDescription: "This should be filled out if the connector type is `postgresql`.",
-				Attributes: map[string]schema.Attribute{
-					"host":          schema.StringAttribute{Required: true, MarkdownDescription: "The hostname of the PostgreSQL database. This can point to a read replica if you are using PostgreSQL 16 or higher, not on Amazon Aurora, and `hot_standby_feedback` is enabled; otherwise it must point to the primary host. This database must also have its `WAL_LEVEL` set to `logical`."},
-					"snapshot_host": schema.StringAttribute{Optional: true, Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}, MarkdownDescription: "The hostname of the PostgreSQL database that we should use to snapshot the database. This can be a read replica and will only be used if this connector is being used as a source. If not provided, we will use the `host` value."},
-					"port": schema.Int32Attribute{
-						Required:            true,
-						MarkdownDescription: "The default port for PostgreSQL is 5432.",
-						Validators: []validator.Int32{
-							int32validator.Between(1024, math.MaxUint16),
-						},
-					},
-					"username": schema.StringAttribute{Required: true, MarkdownDescription: "The username of the service account we will use to connect to the PostgreSQL database. This service account needs enough permissions to create and read from the replication slot."},
-					"password": schema.StringAttribute{Required: true, Sensitive: true, MarkdownDescription: "The password of the service account. We recommend storing this in a secret manager and referencing it via a *sensitive* Terraform variable, instead of putting it in plaintext in your Terraform config file."},
-				},
+			"environment_uuid": schema.StringAttribute{
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				MarkdownDescription: "The UUID of the Artie environment that this connector belongs to.",
 			},
-			"redshift_config": schema.SingleNestedAttribute{
-				MarkdownDescription: "This should be filled out if the connector type is `redshift`.",
-				Optional:            true,
-				Attributes: map[string]schema.Attribute{
-					"endpoint": schema.StringAttribute{Required: true, MarkdownDescription: "The endpoint URL of your Redshift cluster. This should include both the host and port."},
-					"username": schema.StringAttribute{Required: true, MarkdownDescription: "The username of the service account we should use to connect to Redshift."},
-					"password": schema.StringAttribute{Required: true, Sensitive: true, MarkdownDescription: "The password for the service account we should use to connect to Redshift. We recommend storing this in a secret manager and referencing it via a *sensitive* Terraform variable, instead of putting it in plaintext in your Terraform config file."},
-				},
+			"is_valid": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether Artie was able to connect using this connector's settings the last time they were checked. This can become false after the connector is created, e.g. if its credentials expire, so it can be used in `check` blocks to get alerted.",
 			},
-			"s3_config": schema.SingleNestedAttribute{
-				MarkdownDescription: "This should be filled out if the connector type is `s3`. You must provide either `role_arn` (for IAM role assumption) or both `access_key_id` and `secret_access_key` (for static credentials).",
-				Optional:            true,
-				Attributes: map[string]schema.Attribute{
-					"access_key_id":     schema.StringAttribute{Optional: true, Computed: true, Default: stringdefault.StaticString(""), MarkdownDescription: "The AWS Access Key ID for the service account we should use to connect to S3. Required if `role_arn` is not set."},
-					"secret_access_key": schema.StringAttribute{Optional: true, Computed: true, Sensitive: true, Default: stringdefault.StaticString(""), MarkdownDescription: "The AWS Secret Access Key for the service account we should use to connect to S3. Required if `role_arn` is not set. We recommend storing this in a secret manager and referencing it via a *sensitive* Terraform variable, instead of putting it in plaintext in your Terraform config file."},
-					"region":            schema.StringAttribute{Required: true, MarkdownDescription: "The AWS region where we should store your data in S3."},
-					"role_arn":          schema.StringAttribute{Optional: true, Computed: true, Default: stringdefault.StaticString(""), MarkdownDescription: "The ARN of the IAM role to assume for connecting to S3. If set, `access_key_id` and `secret_access_key` are not required."},
-					"external_id":       schema.StringAttribute{Optional: true, Computed: true, Default: stringdefault.StaticString(""), MarkdownDescription: "The external ID to use when assuming the IAM role. Only applicable when `role_arn` is set."},
-				},
+			"created_at": schema.StringAttribute{
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				MarkdownDescription: "When the connector was created, in RFC 3339 format.",
 			},
-			"snowflake_config": schema.SingleNestedAttribute{
-				MarkdownDescription: "This should be filled out if the connector type is `snowflake`.",
-				Optional:            true,
-				Attributes: map[string]schema.Attribute{
-					"account_identifier": schema.StringAttribute{Optional: true, Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}, MarkdownDescription: "The [account identifier](https://docs.snowflake.com/user-guide/admin-account-identifier) of your Snowflake account. We recommend using this instead of `account_url`."},
-					"account_url":        schema.StringAttribute{Optional: true, Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}, MarkdownDescription: "(Legacy) The [URL](https://docs.snowflake.com/user-guide/admin-account-identifier) of your Snowflake account. We recommend using `account_identifier` instead."},
-					"virtual_dwh":        schema.StringAttribute{Required: true, MarkdownDescription: "The name of your Snowflake virtual data warehouse."},
-					"username":           schema.StringAttribute{Required: true, MarkdownDescription: "The username of the service account we should use to connect to Snowflake."},
-					"password":           schema.StringAttribute{Optional: true, Computed: true, Sensitive: true, Default: stringdefault.StaticString(""), MarkdownDescription: "(Legacy) The password for the service account we should use to connect to Snowflake. We recommend using `private_key` instead."},
-					"private_key":        schema.StringAttribute{Optional: true, Computed: true, Sensitive: true, Default: stringdefault.StaticString(""), MarkdownDescription: "The private key for the service account we should use to connect to Snowflake. We recommend using this instead of `password`."},
-				},
+			"updated_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "When the connector was last updated, in RFC 3339 format.",
 			},
-			"databricks_config": schema.SingleNestedAttribute{
-				MarkdownDescription: "This should be filled out if the connector type is `databricks`. Exactly one authentication method must be configured: either `personal_access_token` alone, or both `client_id` and `client_secret` together (OAuth M2M).",
+			"test_connection_on_plan": schema.BoolAttribute{
 				Optional:            true,
-				Attributes: map[string]schema.Attribute{
-					"host":                  schema.StringAttribute{Required: true, MarkdownDescription: "The hostname of the Databricks cluster."},
-					"http_path":             schema.StringAttribute{Required: true, MarkdownDescription: "The HTTP path of the Databricks cluster."},
-					"personal_access_token": schema.StringAttribute{Optional: true, Computed: true, Sensitive: true, Default: stringdefault.StaticString(""), MarkdownDescription: "The personal access token for the service account we should use to connect to Databricks. Conflicts with `client_id` and `client_secret`."},
-					"client_id":             schema.StringAttribute{Optional: true, Computed: true, Default: stringdefault.StaticString(""), MarkdownDescription: "The OAuth M2M client ID for authenticating with Databricks. Must be provided together with `client_secret`. Conflicts with `personal_access_token`."},
-					"client_secret":         schema.StringAttribute{Optional: true, Computed: true, Sensitive: true, Default: stringdefault.StaticString(""), MarkdownDescription: "The OAuth M2M client secret for authenticating with Databricks. Must be provided together with `client_id`. Conflicts with `personal_access_token`."},
-					"volume":                schema.StringAttribute{Required: true, MarkdownDescription: "The volume of the Databricks cluster."},
-				},
+				MarkdownDescription: "If set to true, we will test the connection to this connector during `terraform plan` whenever it is being created or changed, so that problems such as a wrong password are reported before anything is applied. The test is skipped if any of the connector's settings are only known after apply. Defaults to the provider's `test_connections_on_plan` setting.",
 			},
 		},
 	}
+
+	for _, definition := range connectors.All() {
+		if definition.HasConfig() {
+			resp.Schema.Attributes[definition.AttributeName()] = definition.Attribute()
+		}
+	}
 }
 
 func (r *ConnectorResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -304,196 +135,104 @@ func (r *ConnectorResource) Configure(ctx context.Context, req resource.Configur
 	}
 
 	r.client = client
+	r.testConnectionsOnPlan = providerData.TestConnectionsOnPlan
+	r.connectorPings = providerData.connectorPings
 }
 
 func (r *ConnectorResource) GetUUIDFromState(ctx context.Context, state tfsdk.State, diagnostics *diag.Diagnostics) (string, bool) {
-	var stateData tfmodels.Connector
-	diagnostics.Append(state.Get(ctx, &stateData)...)
-	return stateData.UUID.ValueString(), diagnostics.HasError()
+	var connectorUUID types.String
+	diagnostics.Append(state.GetAttribute(ctx, path.Root("uuid"), &connectorUUID)...)
+	return connectorUUID.ValueString(), diagnostics.HasError()
 }
 
-func (r *ConnectorResource) GetPlanData(ctx context.Context, plan tfsdk.Plan, diagnostics *diag.Diagnostics) (tfmodels.Connector, bool) {
-	var planData tfmodels.Connector
-	diagnostics.Append(plan.Get(ctx, &planData)...)
+func (r *ConnectorResource) GetPlanData(ctx context.Context, plan tfsdk.Plan, config tfsdk.Config, diagnostics *diag.Diagnostics) (connectors.Connector, bool) {
+	planData, diags := connectors.GetWithWriteOnly(ctx, plan, config)
+	diagnostics.Append(diags...)
 	return planData, diagnostics.HasError()
 }
 
-func (r *ConnectorResource) SetStateData(ctx context.Context, state *tfsdk.State, diagnostics *diag.Diagnostics, apiConnector artieclient.Connector) {
+func (r *ConnectorResource) SetStateData(ctx context.Context, state *tfsdk.State, diagnostics *diag.Diagnostics, apiConnector artieclient.ConnectorDetails, prior connectors.Connector) {
 	// Translate API response type into Terraform model and save it into state
-	connector, diags := tfmodels.ConnectorFromAPIModel(apiConnector)
+	connector, diags := connectors.ConnectorFromAPIModel(apiConnector)
 	diagnostics.Append(diags...)
 	if diagnostics.HasError() {
 		return
 	}
 
-	diagnostics.Append(state.Set(ctx, connector)...)
+	// The API doesn't return secrets, so keep the ones from the plan or the prior state
+	diagnostics.Append(connector.KeepSecrets(ctx, prior)...)
+	if diagnostics.HasError() {
+		return
+	}
+	connector.TestConnectionOnPlan = prior.TestConnectionOnPlan
+
+	diagnostics.Append(connectors.Set(ctx, state, connector)...)
 }
 
 func (r *ConnectorResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
-	var configData tfmodels.Connector
-	resp.Diagnostics.Append(req.Config.Get(ctx, &configData)...)
+	configData, diags := connectors.Get(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	switch configData.Type.ValueString() {
-	case string(artieclient.BigQuery):
-		if configData.BigQueryConfig == nil {
-			resp.Diagnostics.AddError("bigquery_config is required", "Please provide `bigquery_config` inside `connector`.")
-			return
-		}
-	case string(artieclient.CockroachDB):
-		if configData.CockroachDBConfig == nil {
-			resp.Diagnostics.AddError("cockroach_config is required", "Please provide `cockroach_config` inside `connector`.")
-			return
-		}
-	case string(artieclient.DynamoDB):
-		if configData.DynamoDBConfig == nil {
-			resp.Diagnostics.AddError("dynamodb_config is required", "Please provide `dynamodb_config` inside `connector`.")
-			return
-		}
-
-		if tfmodels.IsKnownAndEmpty(configData.DynamoDBConfig.RoleARN) {
-			if tfmodels.IsKnownAndEmpty(configData.DynamoDBConfig.AwsAccessKeyID) {
-				resp.Diagnostics.AddError("access_key_id is required", "Please provide `access_key_id` inside `dynamodb_config`, or set `role_arn` to use IAM role assumption instead.")
+	if tfmodels.IsKnownAndNonEmpty(configData.SSHTunnelUUID) {
+		for _, attr := range []struct {
+			name  string
+			value types.String
+		}{
+			{"private_link_uuid", configData.PrivateLinkUUID},
+			{"snapshot_private_link_uuid", configData.SnapshotPrivateLinkUUID},
+		} {
+			if tfmodels.IsKnownAndNonEmpty(attr.value) {
+				resp.Diagnostics.AddAttributeError(path.Root(attr.name), "Cannot use an SSH tunnel and a PrivateLink together",
+					fmt.Sprintf("`%s` cannot be set if `ssh_tunnel_uuid` is also set.", attr.name))
 			}
-			if tfmodels.IsKnownAndEmpty(configData.DynamoDBConfig.AwsSecretAccessKey) {
-				resp.Diagnostics.AddError("secret_access_key is required", "Please provide `secret_access_key` inside `dynamodb_config`, or set `role_arn` to use IAM role assumption instead.")
-			}
-		}
-	case string(artieclient.GCS):
-		if configData.GCSConfig == nil {
-			resp.Diagnostics.AddError("gcs_config is required", "Please provide `gcs_config` inside `connector`.")
-			return
-		}
-	case string(artieclient.Iceberg):
-		if configData.IcebergConfig == nil {
-			resp.Diagnostics.AddError("iceberg_config is required", "Please provide `iceberg_config` inside `connector`.")
-			return
 		}
+	}
 
-		switch configData.IcebergConfig.Provider.ValueString() {
-		case "s3tables":
-			if tfmodels.IsKnownAndEmpty(configData.IcebergConfig.AwsAccessKeyID) {
-				resp.Diagnostics.AddError("access_key_id is required for s3tables", "Please provide `access_key_id` inside `iceberg_config` when using `s3tables` provider.")
-			}
-			if tfmodels.IsKnownAndEmpty(configData.IcebergConfig.AwsSecretAccessKey) {
-				resp.Diagnostics.AddError("secret_access_key is required for s3tables", "Please provide `secret_access_key` inside `iceberg_config` when using `s3tables` provider.")
-			}
-			if tfmodels.IsKnownAndEmpty(configData.IcebergConfig.BucketARN) {
-				resp.Diagnostics.AddError("bucket_arn is required for s3tables", "Please provide `bucket_arn` inside `iceberg_config` when using `s3tables` provider.")
-			}
-		case "rest":
-			if tfmodels.IsKnownAndEmpty(configData.IcebergConfig.URI) {
-				resp.Diagnostics.AddError("uri is required for rest catalog", "Please provide `uri` inside `iceberg_config` when using `rest` provider.")
-			}
-			if tfmodels.IsKnownAndEmpty(configData.IcebergConfig.Warehouse) {
-				resp.Diagnostics.AddError("warehouse is required for rest catalog", "Please provide `warehouse` inside `iceberg_config` when using `rest` provider.")
-			}
-			if tfmodels.IsKnownAndEmpty(configData.IcebergConfig.Token) && tfmodels.IsKnownAndEmpty(configData.IcebergConfig.Credential) {
-				resp.Diagnostics.AddError("token or credential is required for rest catalog", "Please provide either `token` or `credential` inside `iceberg_config` when using `rest` provider.")
-			}
-			if tfmodels.IsKnownAndEmpty(configData.IcebergConfig.Token) && tfmodels.IsKnownAndNonEmpty(configData.IcebergConfig.Credential) && tfmodels.IsKnownAndEmpty(configData.IcebergConfig.AuthURI) {
-				resp.Diagnostics.AddError("auth_uri is required when using credential", "Please provide `auth_uri` inside `iceberg_config` when using `credential` authentication without `token`.")
-			}
-		}
-	case string(artieclient.Keyspaces):
-		if configData.KeyspacesConfig == nil {
-			resp.Diagnostics.AddError("keyspaces_config is required", "Please provide `keyspaces_config` inside `connector`.")
-			return
-		}
+	resp.Diagnostics.Append(configData.Validate()...)
+}
 
-		if tfmodels.IsKnownAndEmpty(configData.KeyspacesConfig.RoleARN) {
-			if tfmodels.IsKnownAndEmpty(configData.KeyspacesConfig.AwsAccessKeyID) {
-				resp.Diagnostics.AddError("access_key_id is required", "Please provide `access_key_id` inside `keyspaces_config`, or set `role_arn` to use IAM role assumption instead.")
-			}
-			if tfmodels.IsKnownAndEmpty(configData.KeyspacesConfig.AwsSecretAccessKey) {
-				resp.Diagnostics.AddError("secret_access_key is required", "Please provide `secret_access_key` inside `keyspaces_config`, or set `role_arn` to use IAM role assumption instead.")
-			}
-		}
-	case string(artieclient.MongoDB):
-		if configData.MongoDBConfig == nil {
-			resp.Diagnostics.AddError("mongodb_config is required", "Please provide `mongodb_config` inside `connector`.")
-			return
-		}
-	case string(artieclient.MySQL):
-		if configData.MySQLConfig == nil {
-			resp.Diagnostics.AddError("mysql_config is required", "Please provide `mysql_config` inside `connector`.")
-			return
-		}
-	case string(artieclient.MSSQL):
-		if configData.MSSQLConfig == nil {
-			resp.Diagnostics.AddError("mssql_config is required", "Please provide `mssql_config` inside `connector`.")
-			return
-		}
-	case string(artieclient.Oracle):
-		if configData.OracleConfig == nil {
-			resp.Diagnostics.AddError("oracle_config is required", "Please provide `oracle_config` inside `connector`.")
-			return
-		}
-	case string(artieclient.PostgreSQL):
-		if configData.PostgresConfig == nil {
-			resp.Diagnostics.AddError("postgresql_config is required", "Please provide `postgresql_config` inside `connector`.")
-			return
-		}
-	case string(artieclient.Redshift):
-		if configData.RedshiftConfig == nil {
-			resp.Diagnostics.AddError("redshift_config is required", "Please provide `redshift_config` inside `connector`.")
-			return
-		}
-	case string(artieclient.S3):
-		if configData.S3Config == nil {
-			resp.Diagnostics.AddError("s3_config is required", "Please provide `s3_config` inside `connector`.")
-			return
-		}
+func (r *ConnectorResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to test if the connector is being destroyed or hasn't changed.
+	if req.Plan.Raw.IsNull() || req.Plan.Raw.Equal(req.State.Raw) {
+		return
+	}
 
-		if tfmodels.IsKnownAndEmpty(configData.S3Config.RoleARN) {
-			if tfmodels.IsKnownAndEmpty(configData.S3Config.AccessKeyID) {
-				resp.Diagnostics.AddError("access_key_id is required", "Please provide `access_key_id` inside `s3_config`, or set `role_arn` to use IAM role assumption instead.")
-			}
-			if tfmodels.IsKnownAndEmpty(configData.S3Config.SecretAccessKey) {
-				resp.Diagnostics.AddError("secret_access_key is required", "Please provide `secret_access_key` inside `s3_config`, or set `role_arn` to use IAM role assumption instead.")
-			}
-		}
-	case string(artieclient.Snowflake):
-		if configData.SnowflakeConfig == nil {
-			resp.Diagnostics.AddError("snowflake_config is required", "Please provide `snowflake_config` inside `connector`.")
-			return
-		}
+	planData, hasError := r.GetPlanData(ctx, req.Plan, req.Config, &resp.Diagnostics)
+	if hasError {
+		return
+	}
 
-		// For snowflake, either account_identifier or account_url must be provided
-		if configData.SnowflakeConfig.AccountIdentifier.IsNull() && configData.SnowflakeConfig.AccountURL.IsNull() {
-			resp.Diagnostics.AddError("Either account_identifier or account_url must be provided", "Please provide either `account_identifier` or `account_url` inside `snowflake_config`. We recommend using `account_identifier`.")
-		}
+	testConnection := r.testConnectionsOnPlan
+	if !planData.TestConnectionOnPlan.IsNull() {
+		testConnection = planData.TestConnectionOnPlan.ValueBool()
+	}
+	if !testConnection {
+		return
+	}
 
-		// Either password or private_key must be provided
-		if configData.SnowflakeConfig.Password.IsNull() && configData.SnowflakeConfig.PrivateKey.IsNull() {
-			resp.Diagnostics.AddError("Either password or private_key must be provided", "Please provide either `password` or `private_key` inside `snowflake_config`. We recommend using `private_key`.")
-		}
-	case string(artieclient.Databricks):
-		if configData.DatabricksConfig == nil {
-			resp.Diagnostics.AddError("databricks_config is required", "Please provide `databricks_config` inside `connector`.")
-			return
-		}
+	// Unknown values in the plan that aren't set in the config are filled in by Artie, so only the config needs to be
+	// fully known.
+	if !req.Config.Raw.IsFullyKnown() {
+		tflog.Info(ctx, "Skipping connection test since some of the connector's settings are only known after apply")
+		return
+	}
 
-		patSet := !configData.DatabricksConfig.PersonalAccessToken.IsNull()
-		clientIDSet := !configData.DatabricksConfig.ClientID.IsNull()
-		clientSecretSet := !configData.DatabricksConfig.ClientSecret.IsNull()
+	baseConnector, diags := planData.ToAPIBaseModel()
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
 
-		if patSet && (clientIDSet || clientSecretSet) {
-			resp.Diagnostics.AddError("Conflicting authentication methods", "`personal_access_token` conflicts with `client_id` and `client_secret`. Please provide either `personal_access_token` or both `client_id` and `client_secret`, not both.")
-		}
-		if !patSet && !clientIDSet && !clientSecretSet {
-			resp.Diagnostics.AddError("Authentication is required", "Please provide either `personal_access_token` or both `client_id` and `client_secret` inside `databricks_config`.")
-		}
-		if clientIDSet != clientSecretSet {
-			resp.Diagnostics.AddError("Incomplete OAuth M2M configuration", "Both `client_id` and `client_secret` must be provided together inside `databricks_config`.")
-		}
+	if err := r.connectorPings.TestConnection(ctx, r.client, baseConnector); err != nil {
+		resp.Diagnostics.AddError("Connection test failed", err.Error())
 	}
 }
 
 func (r *ConnectorResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	planData, hasError := r.GetPlanData(ctx, req.Plan, &resp.Diagnostics)
+	planData, hasError := r.GetPlanData(ctx, req.Plan, req.Config, &resp.Diagnostics)
 	if hasError {
 		return
 	}
@@ -510,26 +249,33 @@ func (r *ConnectorResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
-	r.SetStateData(ctx, &resp.State, &resp.Diagnostics, connector)
+	r.SetStateData(ctx, &resp.State, &resp.Diagnostics, connector, planData)
 }
 
 func (r *ConnectorResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	connectorUUID, hasError := r.GetUUIDFromState(ctx, req.State, &resp.Diagnostics)
-	if hasError {
+	stateData, diags := connectors.Get(ctx, req.State)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	connectorUUID := stateData.UUID.ValueString()
 	connector, err := r.client.Connectors().Get(ctx, connectorUUID)
 	if err != nil {
+		if errors.As(err, &artieclient.NotFoundError{}) {
+			tflog.Warn(ctx, fmt.Sprintf("Connector %s no longer exists, removing it from state", connectorUUID))
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError("Unable to Read Connector", err.Error())
 		return
 	}
 
-	r.SetStateData(ctx, &resp.State, &resp.Diagnostics, connector)
+	r.SetStateData(ctx, &resp.State, &resp.Diagnostics, connector, stateData)
 }
 
 func (r *ConnectorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	planData, hasError := r.GetPlanData(ctx, req.Plan, &resp.Diagnostics)
+	planData, hasError := r.GetPlanData(ctx, req.Plan, req.Config, &resp.Diagnostics)
 	if hasError {
 		return
 	}
@@ -546,7 +292,7 @@ func (r *ConnectorResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
-	r.SetStateData(ctx, &resp.State, &resp.Diagnostics, updatedConnector)
+	r.SetStateData(ctx, &resp.State, &resp.Diagnostics, updatedConnector, planData)
 }
 
 func (r *ConnectorResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -0,0 +1,525 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	tfresource "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"terraform-provider-artie/internal/artiefake"
+	"terraform-provider-artie/internal/provider/connectors"
+)
+
+func TestConnectorResource_ReadNotFound(t *testing.T) {
+	testReadRemovesMissingResource(t, NewConnectorResource)
+}
+
+func testAccConnectorConfig(host string) string {
+	return fmt.Sprintf(`
+resource "artie_ssh_tunnel" "test" {
+  name     = "Tunnel"
+  host     = "1.2.3.4"
+  port     = 22
+  username = "artie"
+}
+
+resource "artie_connector" "test" {
+  name = "Postgres"
+  type = "postgresql"
+  postgresql_config = {
+    host     = %q
+    port     = 5432
+    username = "artie"
+    password = "hunter2"
+  }
+  ssh_tunnel_uuid = artie_ssh_tunnel.test.uuid
+}
+`, host)
+}
+
+func TestAccConnectorResource(t *testing.T) {
+	server := newTestAccServer(t)
+	tfresource.Test(t, tfresource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(server),
+		Steps: []tfresource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + testAccConnectorConfig("db.example.com"),
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckResourceAttr("artie_connector.test", "type", "postgresql"),
+					tfresource.TestCheckResourceAttr("artie_connector.test", "postgresql_config.host", "db.example.com"),
+					tfresource.TestCheckResourceAttrPair("artie_connector.test", "ssh_tunnel_uuid", "artie_ssh_tunnel.test", "uuid"),
+					tfresource.TestCheckResourceAttrSet("artie_connector.test", "uuid"),
+					tfresource.TestCheckResourceAttrSet("artie_connector.test", "data_plane_name"),
+					tfresource.TestCheckResourceAttr("artie_connector.test", "environment_uuid", artiefake.EnvironmentUUID.String()),
+					tfresource.TestCheckResourceAttr("artie_connector.test", "is_valid", "true"),
+					tfresource.TestCheckResourceAttrSet("artie_connector.test", "created_at"),
+					tfresource.TestCheckResourceAttrSet("artie_connector.test", "updated_at"),
+				),
+			},
+			{
+				Config: testAccProviderConfig(server) + testAccConnectorConfig("replica.example.com"),
+				Check:  tfresource.TestCheckResourceAttr("artie_connector.test", "postgresql_config.host", "replica.example.com"),
+			},
+			{
+				ResourceName:                         "artie_connector.test",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "uuid",
+				ImportStateIdFunc:                    testAccImportStateUUID("artie_connector.test"),
+				// The API doesn't return secrets, so they can't be imported.
+				ImportStateVerifyIgnore: []string{"postgresql_config.password"},
+			},
+		},
+	})
+}
+
+func TestAccConnectorResource_ClickHouse(t *testing.T) {
+	server := newTestAccServer(t)
+	tfresource.Test(t, tfresource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(server),
+		Steps: []tfresource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + `
+resource "artie_connector" "test" {
+  name = "ClickHouse"
+  type = "clickhouse"
+  clickhouse_config = {
+    host     = "abc123.us-east-1.aws.clickhouse.cloud"
+    port     = 9440
+    username = "artie"
+    password = "hunter2"
+  }
+}
+`,
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckResourceAttr("artie_connector.test", "type", "clickhouse"),
+					tfresource.TestCheckResourceAttr("artie_connector.test", "clickhouse_config.database", "default"),
+					tfresource.TestCheckResourceAttr("artie_connector.test", "clickhouse_config.tls_enabled", "true"),
+					tfresource.TestCheckResourceAttr("artie_connector.test", "clickhouse_config.tls_skip_verify", "false"),
+				),
+			},
+			{
+				ResourceName:                         "artie_connector.test",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "uuid",
+				ImportStateIdFunc:                    testAccImportStateUUID("artie_connector.test"),
+				// The API doesn't return secrets, so they can't be imported.
+				ImportStateVerifyIgnore: []string{"clickhouse_config.password"},
+			},
+		},
+	})
+}
+
+func TestAccConnectorResource_PlanetScale(t *testing.T) {
+	server := newTestAccServer(t)
+	tfresource.Test(t, tfresource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(server),
+		Steps: []tfresource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + `
+resource "artie_connector" "test" {
+  name = "PlanetScale"
+  type = "planetscale"
+  planetscale_config = {
+    host     = "aws.connect.psdb.cloud"
+    username = "artie"
+    password = "hunter2"
+    shards   = ["-80", "80-"]
+  }
+}
+
+resource "artie_source_reader" "test" {
+  name           = "PlanetScale"
+  connector_uuid = artie_connector.test.uuid
+  database_name  = "orders"
+}
+`,
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckResourceAttr("artie_connector.test", "type", "planetscale"),
+					tfresource.TestCheckResourceAttr("artie_connector.test", "planetscale_config.port", "3306"),
+					tfresource.TestCheckResourceAttr("artie_connector.test", "planetscale_config.branch", "main"),
+					tfresource.TestCheckResourceAttr("artie_connector.test", "planetscale_config.shards.#", "2"),
+					tfresource.TestCheckResourceAttr("artie_source_reader.test", "database_name", "orders"),
+				),
+			},
+			{
+				ResourceName:                         "artie_connector.test",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "uuid",
+				ImportStateIdFunc:                    testAccImportStateUUID("artie_connector.test"),
+				// The API doesn't return secrets, so they can't be imported.
+				ImportStateVerifyIgnore: []string{"planetscale_config.password"},
+			},
+		},
+	})
+}
+
+func TestAccConnectorResource_PrivateLink(t *testing.T) {
+	server := newTestAccServer(t)
+	privateLinks := `
+resource "artie_private_link" "primary" {
+  name             = "Primary"
+  vpc_service_name = "com.amazonaws.vpce.us-east-1.vpce-svc-0123456789abcdef0"
+  az_ids           = ["use1-az1", "use1-az2"]
+}
+
+resource "artie_private_link" "replica" {
+  name             = "Replica"
+  vpc_service_name = "com.amazonaws.vpce.us-east-1.vpce-svc-0fedcba9876543210"
+  az_ids           = ["use1-az1", "use1-az2"]
+}
+`
+	tfresource.Test(t, tfresource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(server),
+		Steps: []tfresource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + privateLinks + `
+resource "artie_ssh_tunnel" "test" {
+  name     = "Tunnel"
+  host     = "1.2.3.4"
+  port     = 22
+  username = "artie"
+}
+
+resource "artie_connector" "test" {
+  name = "Postgres"
+  type = "postgresql"
+  postgresql_config = {
+    host     = "db.internal"
+    port     = 5432
+    username = "artie"
+    password = "hunter2"
+  }
+  ssh_tunnel_uuid   = artie_ssh_tunnel.test.uuid
+  private_link_uuid = "7b4dc1a0-3c2e-4c9a-9d0e-2f6f4b8e9a11"
+}
+`,
+				ExpectError: regexp.MustCompile("Cannot use an SSH tunnel and a PrivateLink together"),
+			},
+			{
+				Config: testAccProviderConfig(server) + privateLinks + `
+resource "artie_connector" "test" {
+  name = "Postgres"
+  type = "postgresql"
+  postgresql_config = {
+    host     = "db.internal"
+    port     = 5432
+    username = "artie"
+    password = "hunter2"
+  }
+  private_link_uuid          = artie_private_link.primary.uuid
+  snapshot_private_link_uuid = artie_private_link.replica.uuid
+}
+`,
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckResourceAttrPair("artie_connector.test", "private_link_uuid", "artie_private_link.primary", "uuid"),
+					tfresource.TestCheckResourceAttrPair("artie_connector.test", "snapshot_private_link_uuid", "artie_private_link.replica", "uuid"),
+					tfresource.TestCheckResourceAttr("artie_connector.test", "ssh_tunnel_uuid", ""),
+				),
+			},
+			{
+				ResourceName:                         "artie_connector.test",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "uuid",
+				ImportStateIdFunc:                    testAccImportStateUUID("artie_connector.test"),
+				// The API doesn't return secrets, so they can't be imported.
+				ImportStateVerifyIgnore: []string{"postgresql_config.password"},
+			},
+		},
+	})
+}
+
+func TestConnectorResource_WriteOnlySecret(t *testing.T) {
+	ctx := t.Context()
+	server := newTestAccServer(t)
+	client, err := newTestProviderData(server.URL).NewClient()
+	require.NoError(t, err)
+
+	r := NewConnectorResource()
+	configureTestResource(t, r.(resource.ResourceWithConfigure), server.URL)
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	newState := func(connector connectors.Connector) tfsdk.State {
+		state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+		diags := connectors.Set(ctx, &state, connector)
+		require.False(t, diags.HasError(), diags)
+		return state
+	}
+
+	connector := connectors.Connector{
+		UUID:                    types.StringUnknown(),
+		SSHTunnelUUID:           types.StringUnknown(),
+		PrivateLinkUUID:         types.StringUnknown(),
+		SnapshotPrivateLinkUUID: types.StringUnknown(),
+		Type:                    types.StringValue("postgresql"),
+		Name:                    types.StringValue("Postgres"),
+		DataPlaneName:           types.StringUnknown(),
+	}
+	planConfig := connectors.PostgresSharedConfig{
+		Host:              types.StringValue("db.example.com"),
+		SnapshotHost:      types.StringValue(""),
+		Port:              types.Int32Value(5432),
+		Username:          types.StringValue("artie"),
+		PasswordWOVersion: types.Int64Value(1),
+	}
+	connector.Config = &planConfig
+	plan := newState(connector)
+	// Write-only values are only in the config.
+	configConfig := planConfig
+	configConfig.PasswordWO = types.StringValue("hunter2")
+	connector.Config = &configConfig
+	config := newState(connector)
+
+	createResp := resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}}
+	r.Create(ctx, resource.CreateRequest{Plan: tfsdk.Plan(plan), Config: tfsdk.Config(config)}, &createResp)
+	require.False(t, createResp.Diagnostics.HasError(), createResp.Diagnostics)
+
+	created, diags := connectors.Get(ctx, createResp.State)
+	require.False(t, diags.HasError(), diags)
+	createdConfig := created.Config.(*connectors.PostgresSharedConfig)
+	assert.True(t, createdConfig.Password.IsNull())
+	assert.True(t, createdConfig.PasswordWO.IsNull())
+	assert.Equal(t, int64(1), createdConfig.PasswordWOVersion.ValueInt64())
+
+	// The secret was sent to the API even though it isn't in state.
+	apiConnector, err := client.Connectors().Get(ctx, created.UUID.ValueString())
+	require.NoError(t, err)
+	assert.Equal(t, "hunter2", apiConnector.Config.String("password"))
+
+	// Refreshing keeps the version, since the API doesn't return it.
+	readResp := resource.ReadResponse{State: createResp.State}
+	r.Read(ctx, resource.ReadRequest{State: createResp.State}, &readResp)
+	require.False(t, readResp.Diagnostics.HasError(), readResp.Diagnostics)
+	read, diags := connectors.Get(ctx, readResp.State)
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, created, read)
+}
+
+func TestAccConnectorResource_WriteOnlySecret(t *testing.T) {
+	server := newTestAccServer(t)
+	config := func(passwordVersion int) string {
+		return testAccProviderConfig(server) + fmt.Sprintf(`
+resource "artie_connector" "test" {
+  name = "Postgres"
+  type = "postgresql"
+  postgresql_config = {
+    host                = "db.example.com"
+    port                = 5432
+    username            = "artie"
+    password_wo         = "hunter%d"
+    password_wo_version = %d
+  }
+}
+`, passwordVersion, passwordVersion)
+	}
+	tfresource.Test(t, tfresource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks:   []tfversion.TerraformVersionCheck{tfversion.SkipBelow(tfversion.Version1_11_0)},
+		CheckDestroy:             testAccCheckDestroy(server),
+		Steps: []tfresource.TestStep{
+			{
+				Config: config(1),
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckNoResourceAttr("artie_connector.test", "postgresql_config.password"),
+					tfresource.TestCheckNoResourceAttr("artie_connector.test", "postgresql_config.password_wo"),
+					tfresource.TestCheckResourceAttr("artie_connector.test", "postgresql_config.password_wo_version", "1"),
+				),
+			},
+			{
+				// Rotating the secret means bumping its version.
+				Config: config(2),
+				Check:  tfresource.TestCheckResourceAttr("artie_connector.test", "postgresql_config.password_wo_version", "2"),
+			},
+		},
+	})
+}
+
+func TestConnectorResource_ValidateConfigUnknownList(t *testing.T) {
+	ctx := t.Context()
+	var schemaResp resource.SchemaResponse
+	NewConnectorResource().Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	config := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+	// shards might come from another resource or a module output, so it isn't known until apply.
+	diags := connectors.Set(ctx, &config, connectors.Connector{
+		Type: types.StringValue("planetscale"),
+		Name: types.StringValue("Orders"),
+		Config: &connectors.PlanetScaleSharedConfig{
+			Host:     types.StringValue("aws.connect.psdb.cloud"),
+			Port:     types.Int32Value(3306),
+			Username: types.StringValue("artie"),
+			Password: types.StringValue("pscale_pw_hunter2"),
+			Branch:   types.StringValue("main"),
+			Shards:   types.ListUnknown(types.StringType),
+		},
+	})
+	require.False(t, diags.HasError(), diags)
+
+	var resp resource.ValidateConfigResponse
+	NewConnectorResource().(*ConnectorResource).ValidateConfig(ctx, resource.ValidateConfigRequest{Config: tfsdk.Config(config)}, &resp)
+	assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+}
+
+func TestConnectorResource_ModifyPlan(t *testing.T) {
+	ctx := t.Context()
+	server := newTestAccServer(t)
+	newResource := func(testConnectionsOnPlan bool) *ConnectorResource {
+		providerData := newTestProviderData(server.URL)
+		providerData.TestConnectionsOnPlan = testConnectionsOnPlan
+		r := &ConnectorResource{}
+		var resp resource.ConfigureResponse
+		r.Configure(ctx, resource.ConfigureRequest{ProviderData: providerData}, &resp)
+		require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+		return r
+	}
+	var schemaResp resource.SchemaResponse
+	NewConnectorResource().Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	newState := func(connector connectors.Connector) tfsdk.State {
+		state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+		diags := connectors.Set(ctx, &state, connector)
+		require.False(t, diags.HasError(), diags)
+		return state
+	}
+	// modifyPlan plans config, which is a new connector if prior is nil.
+	modifyPlan := func(r *ConnectorResource, prior *connectors.Connector, config connectors.Connector) diag.Diagnostics {
+		// The plan only differs from the config in values that are computed by Artie.
+		planData := config
+		planData.UUID = types.StringUnknown()
+		state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+		if prior != nil {
+			planData.UUID = prior.UUID
+			state = newState(*prior)
+		}
+		plan := tfsdk.Plan(newState(planData))
+		resp := resource.ModifyPlanResponse{Plan: plan}
+		r.ModifyPlan(ctx, resource.ModifyPlanRequest{State: state, Plan: plan, Config: tfsdk.Config(newState(config))}, &resp)
+		return resp.Diagnostics
+	}
+
+	// A connector that can't be reached, since its SSH tunnel doesn't exist.
+	unreachable := connectors.Connector{
+		Type:          types.StringValue("postgresql"),
+		Name:          types.StringValue("Postgres"),
+		SSHTunnelUUID: types.StringValue(uuid.NewString()),
+		Config: &connectors.PostgresSharedConfig{
+			Host:     types.StringValue("db.example.com"),
+			Port:     types.Int32Value(5432),
+			Username: types.StringValue("artie"),
+			Password: types.StringValue("hunter2"),
+		},
+	}
+	{
+		// Connections aren't tested by default.
+		assert.False(t, modifyPlan(newResource(false), nil, unreachable).HasError())
+	}
+	{
+		diags := modifyPlan(newResource(true), nil, unreachable)
+		require.True(t, diags.HasError())
+		assert.Equal(t, "Connection test failed", diags[0].Summary())
+		assert.Contains(t, diags[0].Detail(), "ssh tunnel")
+	}
+	{
+		// The resource's setting overrides the provider's.
+		connector := unreachable
+		connector.TestConnectionOnPlan = types.BoolValue(true)
+		assert.True(t, modifyPlan(newResource(false), nil, connector).HasError())
+		connector.TestConnectionOnPlan = types.BoolValue(false)
+		assert.False(t, modifyPlan(newResource(true), nil, connector).HasError())
+	}
+	{
+		connector := unreachable
+		connector.SSHTunnelUUID = types.StringNull()
+		assert.False(t, modifyPlan(newResource(true), nil, connector).HasError())
+	}
+	{
+		// The test is skipped if the config has values that are only known after apply.
+		connector := unreachable
+		connector.SSHTunnelUUID = types.StringUnknown()
+		assert.False(t, modifyPlan(newResource(true), nil, connector).HasError())
+	}
+	{
+		// Only connectors that changed are tested.
+		prior := unreachable
+		prior.UUID = types.StringValue(uuid.NewString())
+		assert.False(t, modifyPlan(newResource(true), &prior, unreachable).HasError())
+		prior.Name = types.StringValue("Old name")
+		assert.True(t, modifyPlan(newResource(true), &prior, unreachable).HasError())
+	}
+}
+
+func TestAccConnectorResource_TestConnectionOnPlan(t *testing.T) {
+	server := newTestAccServer(t)
+	tfresource.Test(t, tfresource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []tfresource.TestStep{
+			{
+				// The SSH tunnel doesn't exist, so the connection test fails before anything is created.
+				Config: testAccProviderConfig(server) + fmt.Sprintf(`
+resource "artie_connector" "test" {
+  name                    = "Postgres"
+  type                    = "postgresql"
+  ssh_tunnel_uuid         = %q
+  test_connection_on_plan = true
+  postgresql_config = {
+    host     = "db.example.com"
+    port     = 5432
+    username = "artie"
+    password = "hunter2"
+  }
+}
+`, uuid.NewString()),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Connection test failed`),
+			},
+		},
+	})
+}
+
+func TestAccConnectorResource_DefaultDatabase(t *testing.T) {
+	server := newTestAccServer(t)
+	config := func(defaultDatabase string) string {
+		return testAccProviderConfig(server) + fmt.Sprintf(`
+resource "artie_connector" "test" {
+  name             = "Postgres"
+  type             = "postgresql"
+  default_database = %q
+  postgresql_config = {
+    host     = "db.example.com"
+    port     = 5432
+    username = "artie"
+    password = "hunter2"
+  }
+}
+`, defaultDatabase)
+	}
+	tfresource.Test(t, tfresource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(server),
+		Steps: []tfresource.TestStep{
+			{
+				Config: config("orders"),
+				Check:  tfresource.TestCheckResourceAttr("artie_connector.test", "default_database", "orders"),
+			},
+			{
+				Config: config("analytics"),
+				Check:  tfresource.TestCheckResourceAttr("artie_connector.test", "default_database", "analytics"),
+			},
+		},
+	})
+}
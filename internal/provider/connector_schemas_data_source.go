@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-artie/internal/artieclient"
+	"terraform-provider-artie/internal/provider/tfmodels"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ConnectorSchemasDataSource{}
+var _ datasource.DataSourceWithConfigure = &ConnectorSchemasDataSource{}
+
+func NewConnectorSchemasDataSource() datasource.DataSource {
+	return &ConnectorSchemasDataSource{}
+}
+
+type ConnectorSchemasDataSource struct {
+	catalog artieclient.ConnectorCatalogClient
+}
+
+func (d *ConnectorSchemasDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_connector_schemas"
+}
+
+func (d *ConnectorSchemasDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Artie Connector Schemas data source. This lists the schemas in one of a connector's databases.",
+		Attributes: map[string]schema.Attribute{
+			"connector_uuid": schema.StringAttribute{Required: true, MarkdownDescription: "The UUID of the connector to list schemas for."},
+			"database_name":  schema.StringAttribute{Required: true, MarkdownDescription: "The name of the database to list schemas in."},
+			"schemas":        schema.ListAttribute{Computed: true, ElementType: types.StringType, MarkdownDescription: "The names of the schemas in the database."},
+		},
+	}
+}
+
+func (d *ConnectorSchemasDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(ArtieProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected ArtieProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData))
+		return
+	}
+
+	openAPIClient, err := providerData.NewOpenAPIClient()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to build Artie client", err.Error())
+		return
+	}
+
+	d.catalog = artieclient.NewConnectorCatalogClient(openAPIClient)
+}
+
+func (d *ConnectorSchemasDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var configData tfmodels.ConnectorSchemasDataSource
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	schemas, err := d.catalog.Schemas(ctx, configData.ConnectorUUID.ValueString(), configData.DatabaseName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to List Connector Schemas", err.Error())
+		return
+	}
+
+	schemasList, diags := types.ListValueFrom(ctx, types.StringType, schemas)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	configData.Schemas = schemasList
+	resp.Diagnostics.Append(resp.State.Set(ctx, configData)...)
+}
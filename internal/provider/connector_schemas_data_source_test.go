@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"terraform-provider-artie/internal/provider/tfmodels"
+)
+
+func TestConnectorSchemasDataSource_Read(t *testing.T) {
+	ctx := t.Context()
+	server := newTestAccServer(t)
+	connector := createTestCatalogConnector(t, server)
+
+	{
+		d := NewConnectorSchemasDataSource()
+		configureTestDataSource(t, d.(*ConnectorSchemasDataSource), server.URL)
+		resp := readTestDataSource(t, ctx, d, map[string]any{"connector_uuid": connector.UUID.String(), "database_name": "orders"})
+		require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+		var state tfmodels.ConnectorSchemasDataSource
+		resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+		require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+		var schemas []string
+		resp.Diagnostics.Append(state.Schemas.ElementsAs(ctx, &schemas, false)...)
+		require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+		assert.Equal(t, []string{"audit", "public"}, schemas)
+	}
+	{
+		// A database with no tables has no schemas.
+		d := NewConnectorSchemasDataSource()
+		configureTestDataSource(t, d.(*ConnectorSchemasDataSource), server.URL)
+		resp := readTestDataSource(t, ctx, d, map[string]any{"connector_uuid": connector.UUID.String(), "database_name": "postgres"})
+		require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+		var state tfmodels.ConnectorSchemasDataSource
+		resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+		require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+		assert.Empty(t, state.Schemas.Elements())
+		assert.False(t, state.Schemas.IsNull())
+	}
+}
@@ -0,0 +1,116 @@
+package provider
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+
+	"terraform-provider-artie/internal/artieclient"
+	"terraform-provider-artie/internal/provider/tfmodels"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ConnectorTablesDataSource{}
+var _ datasource.DataSourceWithConfigure = &ConnectorTablesDataSource{}
+
+func NewConnectorTablesDataSource() datasource.DataSource {
+	return &ConnectorTablesDataSource{}
+}
+
+type ConnectorTablesDataSource struct {
+	catalog artieclient.ConnectorCatalogClient
+}
+
+func (d *ConnectorTablesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_connector_tables"
+}
+
+func (d *ConnectorTablesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Artie Connector Tables data source. This lists the tables in one of a connector's databases, along with their columns, so that a pipeline's `tables` can be generated from them.",
+		Attributes: map[string]schema.Attribute{
+			"connector_uuid": schema.StringAttribute{Required: true, MarkdownDescription: "The UUID of the connector to list tables for."},
+			"database_name":  schema.StringAttribute{Required: true, MarkdownDescription: "The name of the database to list tables in."},
+			"schema_name":    schema.StringAttribute{Optional: true, MarkdownDescription: "If set, only tables in this schema are returned."},
+			"force_refresh":  schema.BoolAttribute{Optional: true, MarkdownDescription: "Artie caches the list of tables in a database. If this is true, the list is read from the database again instead."},
+			"tables": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The tables in the database, sorted by schema and name.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name":       schema.StringAttribute{Computed: true, MarkdownDescription: "The name of the table."},
+						"schema":     schema.StringAttribute{Computed: true, MarkdownDescription: "The name of the schema the table is in. This is empty for sources that don't have schemas."},
+						"is_view":    schema.BoolAttribute{Computed: true, MarkdownDescription: "Whether this is a view rather than a table."},
+						"unreadable": schema.BoolAttribute{Computed: true, MarkdownDescription: "Whether the connector's user lacks the permissions it needs to replicate this table."},
+						"columns": schema.ListNestedAttribute{
+							Computed:            true,
+							MarkdownDescription: "The columns of the table.",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"name":           schema.StringAttribute{Computed: true, MarkdownDescription: "The name of the column."},
+									"data_type":      schema.StringAttribute{Computed: true, MarkdownDescription: "The column's data type in the source database."},
+									"is_primary_key": schema.BoolAttribute{Computed: true, MarkdownDescription: "Whether the column is part of the table's primary key."},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ConnectorTablesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(ArtieProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected ArtieProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData))
+		return
+	}
+
+	openAPIClient, err := providerData.NewOpenAPIClient()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to build Artie client", err.Error())
+		return
+	}
+
+	d.catalog = artieclient.NewConnectorCatalogClient(openAPIClient)
+}
+
+func (d *ConnectorTablesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var configData tfmodels.ConnectorTablesDataSource
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiTables, err := d.catalog.Tables(
+		ctx,
+		configData.ConnectorUUID.ValueString(),
+		configData.DatabaseName.ValueString(),
+		configData.SchemaName.ValueString(),
+		configData.ForceRefresh.ValueBool(),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to List Connector Tables", err.Error())
+		return
+	}
+
+	configData.Tables = []tfmodels.ConnectorTable{}
+	for _, apiTable := range apiTables {
+		configData.Tables = append(configData.Tables, tfmodels.ConnectorTableFromAPIModel(apiTable))
+	}
+	// Sort the tables so that the data source's result doesn't change if the API returns them in a different order.
+	slices.SortStableFunc(configData.Tables, func(a, b tfmodels.ConnectorTable) int {
+		return cmp.Or(cmp.Compare(a.Schema.ValueString(), b.Schema.ValueString()), cmp.Compare(a.Name.ValueString(), b.Name.ValueString()))
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, configData)...)
+}
@@ -0,0 +1,133 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"terraform-provider-artie/internal/artieclient"
+	"terraform-provider-artie/internal/artiefake"
+	"terraform-provider-artie/internal/lib"
+	"terraform-provider-artie/internal/openapi"
+	"terraform-provider-artie/internal/provider/connectors"
+	"terraform-provider-artie/internal/provider/tfmodels"
+)
+
+// createTestCatalogConnector creates a Postgres connector on server that can see an `orders` database with a few
+// tables in it.
+func createTestCatalogConnector(t *testing.T, server *artiefake.Server) artieclient.ConnectorDetails {
+	client, err := newTestProviderData(server.URL).NewClient()
+	require.NoError(t, err)
+	connector, err := client.Connectors().Create(t.Context(), artieclient.BaseConnector{Type: connectors.PostgreSQL, Label: "orders"})
+	require.NoError(t, err)
+
+	newColumn := func(name, dataType string, isPrimaryKey bool) openapi.PayloadsConnectorColumn {
+		return openapi.PayloadsConnectorColumn{
+			Name:     lib.ToPtr(name),
+			Metadata: &openapi.PayloadsConnectorColumnMetadata{DataType: lib.ToPtr(dataType), IsPrimaryKey: lib.ToPtr(isPrimaryKey)},
+		}
+	}
+	server.SetCatalog(connector.UUID, artiefake.Catalog{
+		"orders": {
+			{Name: lib.ToPtr("order_items"), Schema: lib.ToPtr("public"), Columns: &[]openapi.PayloadsConnectorColumn{newColumn("id", "bigint", true)}},
+			{Name: lib.ToPtr("accounts"), Schema: lib.ToPtr("public"), Columns: &[]openapi.PayloadsConnectorColumn{
+				newColumn("id", "bigint", true),
+				newColumn("email", "text", false),
+			}},
+			{Name: lib.ToPtr("active_accounts"), Schema: lib.ToPtr("public"), IsView: lib.ToPtr(true)},
+			{Name: lib.ToPtr("events"), Schema: lib.ToPtr("audit"), Unreadable: lib.ToPtr(true)},
+		},
+		"postgres": {},
+	})
+	return connector
+}
+
+func TestConnectorTablesDataSource_Read(t *testing.T) {
+	ctx := t.Context()
+	server := newTestAccServer(t)
+	connector := createTestCatalogConnector(t, server)
+
+	{
+		d := NewConnectorTablesDataSource()
+		configureTestDataSource(t, d.(*ConnectorTablesDataSource), server.URL)
+		resp := readTestDataSource(t, ctx, d, map[string]any{"connector_uuid": connector.UUID.String(), "database_name": "orders"})
+		require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+		var state tfmodels.ConnectorTablesDataSource
+		resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+		require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+		var names []string
+		for _, table := range state.Tables {
+			names = append(names, table.Schema.ValueString()+"."+table.Name.ValueString())
+		}
+		assert.Equal(t, []string{"audit.events", "public.accounts", "public.active_accounts", "public.order_items"}, names)
+		assert.True(t, state.Tables[0].Unreadable.ValueBool())
+		assert.True(t, state.Tables[2].IsView.ValueBool())
+		assert.Empty(t, state.Tables[2].Columns)
+		require.Len(t, state.Tables[1].Columns, 2)
+		assert.Equal(t, "email", state.Tables[1].Columns[1].Name.ValueString())
+		assert.Equal(t, "text", state.Tables[1].Columns[1].DataType.ValueString())
+		assert.False(t, state.Tables[1].Columns[1].IsPrimaryKey.ValueBool())
+		assert.True(t, state.Tables[1].Columns[0].IsPrimaryKey.ValueBool())
+	}
+	{
+		d := NewConnectorTablesDataSource()
+		configureTestDataSource(t, d.(*ConnectorTablesDataSource), server.URL)
+		resp := readTestDataSource(t, ctx, d, map[string]any{"connector_uuid": connector.UUID.String(), "database_name": "orders", "schema_name": "audit"})
+		require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+		var state tfmodels.ConnectorTablesDataSource
+		resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+		require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+		require.Len(t, state.Tables, 1)
+		assert.Equal(t, "events", state.Tables[0].Name.ValueString())
+	}
+	{
+		d := NewConnectorTablesDataSource()
+		configureTestDataSource(t, d.(*ConnectorTablesDataSource), server.URL)
+		resp := readTestDataSource(t, ctx, d, map[string]any{"connector_uuid": connector.UUID.String(), "database_name": "missing"})
+		require.True(t, resp.Diagnostics.HasError())
+		assert.Equal(t, "Unable to List Connector Tables", resp.Diagnostics.Errors()[0].Summary())
+	}
+}
+
+func TestAccConnectorTablesDataSource(t *testing.T) {
+	server := newTestAccServer(t)
+	connector := createTestCatalogConnector(t, server)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + fmt.Sprintf(`
+data "artie_connector_databases" "orders" {
+  connector_uuid = %[1]q
+}
+
+data "artie_connector_schemas" "orders" {
+  connector_uuid = %[1]q
+  database_name  = "orders"
+}
+
+data "artie_connector_tables" "public" {
+  connector_uuid = %[1]q
+  database_name  = "orders"
+  schema_name    = "public"
+}
+`, connector.UUID.String()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.artie_connector_databases.orders", "databases.#", "2"),
+					resource.TestCheckResourceAttr("data.artie_connector_databases.orders", "databases.0", "orders"),
+					resource.TestCheckResourceAttr("data.artie_connector_schemas.orders", "schemas.#", "2"),
+					resource.TestCheckResourceAttr("data.artie_connector_schemas.orders", "schemas.0", "audit"),
+					resource.TestCheckResourceAttr("data.artie_connector_tables.public", "tables.#", "3"),
+					resource.TestCheckResourceAttr("data.artie_connector_tables.public", "tables.0.name", "accounts"),
+					resource.TestCheckResourceAttr("data.artie_connector_tables.public", "tables.0.columns.#", "2"),
+					resource.TestCheckResourceAttr("data.artie_connector_tables.public", "tables.0.columns.0.is_primary_key", "true"),
+				),
+			},
+		},
+	})
+}
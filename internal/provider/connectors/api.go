@@ -0,0 +1,10 @@
+package connectors
+
+import "terraform-provider-artie/internal/artieclient"
+
+const API artieclient.ConnectorType = "api"
+
+func init() {
+	// API connectors receive data that is pushed to Artie, so they don't have any settings.
+	register(Definition{Type: API, Direction: Source})
+}
@@ -0,0 +1,47 @@
+package connectors
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-artie/internal/artieclient"
+)
+
+const BigQuery artieclient.ConnectorType = "bigquery"
+
+func init() {
+	register(Definition{
+		Type:      BigQuery,
+		Direction: Destination,
+		Attributes: map[string]schema.Attribute{
+			"project_id":       schema.StringAttribute{Required: true, MarkdownDescription: "The ID of the Google Cloud project."},
+			"location":         schema.StringAttribute{Required: true, MarkdownDescription: "The location of the BigQuery dataset. This must be either `US` or `EU`."},
+			"credentials_data": schema.StringAttribute{Required: true, Sensitive: true, MarkdownDescription: "The credentials data for the Google Cloud service account that we should use to connect to BigQuery. We recommend storing this in a secret manager and referencing it via a *sensitive* Terraform variable, instead of putting it in plaintext in your Terraform config file."},
+		},
+		NewConfig:          func() Config { return &BigQuerySharedConfig{} },
+		ConfigFromAPIModel: func(apiModel artieclient.ConnectorConfig) Config { return BigQuerySharedConfigFromAPIModel(apiModel) },
+	})
+}
+
+type BigQuerySharedConfig struct {
+	ProjectID                types.String `tfsdk:"project_id"`
+	Location                 types.String `tfsdk:"location"`
+	CredentialsData          types.String `tfsdk:"credentials_data"`
+	CredentialsDataWO        types.String `tfsdk:"credentials_data_wo"`
+	CredentialsDataWOVersion types.Int64  `tfsdk:"credentials_data_wo_version"`
+}
+
+func (b BigQuerySharedConfig) ToAPIModel() artieclient.ConnectorConfig {
+	return artieclient.ConnectorConfig{
+		"projectID":       b.ProjectID.ValueString(),
+		"location":        b.Location.ValueString(),
+		"credentialsData": secretValue(b.CredentialsData, b.CredentialsDataWO),
+	}
+}
+
+func BigQuerySharedConfigFromAPIModel(apiModel artieclient.ConnectorConfig) *BigQuerySharedConfig {
+	return &BigQuerySharedConfig{
+		ProjectID: types.StringValue(apiModel.String("projectID")),
+		Location:  types.StringValue(apiModel.String("location")),
+	}
+}
@@ -0,0 +1,84 @@
+package connectors
+
+import (
+	"math"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-artie/internal/artieclient"
+	"terraform-provider-artie/internal/provider/tfmodels"
+)
+
+const ClickHouse artieclient.ConnectorType = "clickhouse"
+
+func init() {
+	register(Definition{
+		Type:      ClickHouse,
+		Direction: Destination,
+		Attributes: map[string]schema.Attribute{
+			"host": schema.StringAttribute{Required: true, MarkdownDescription: "The hostname of the ClickHouse server."},
+			"port": schema.Int32Attribute{
+				Required:            true,
+				MarkdownDescription: "The port of the ClickHouse server's native protocol. The default port is 9440 with TLS and 9000 without it.",
+				Validators: []validator.Int32{
+					int32validator.Between(1024, math.MaxUint16),
+				},
+			},
+			"username":        schema.StringAttribute{Required: true, MarkdownDescription: "The username of the service account we should use to connect to ClickHouse."},
+			"password":        schema.StringAttribute{Required: true, Sensitive: true, MarkdownDescription: "The password of the service account. We recommend storing this in a secret manager and referencing it via a *sensitive* Terraform variable, instead of putting it in plaintext in your Terraform config file."},
+			"database":        schema.StringAttribute{Optional: true, Computed: true, Default: stringdefault.StaticString("default"), MarkdownDescription: "The database we should connect to. Defaults to `default`."},
+			"tls_enabled":     schema.BoolAttribute{Optional: true, Computed: true, Default: booldefault.StaticBool(true), MarkdownDescription: "Whether we should connect to ClickHouse over TLS. Defaults to true, which is required for ClickHouse Cloud."},
+			"tls_skip_verify": schema.BoolAttribute{Optional: true, Computed: true, Default: booldefault.StaticBool(false), MarkdownDescription: "If set to true, we will not verify the server's TLS certificate. This should only be used for self-hosted servers with self-signed certificates. Only applicable when `tls_enabled` is true."},
+		},
+		NewConfig:          func() Config { return &ClickHouseSharedConfig{} },
+		ConfigFromAPIModel: func(apiModel artieclient.ConnectorConfig) Config { return ClickHouseSharedConfigFromAPIModel(apiModel) },
+	})
+}
+
+type ClickHouseSharedConfig struct {
+	Host              types.String `tfsdk:"host"`
+	Port              types.Int32  `tfsdk:"port"`
+	Username          types.String `tfsdk:"username"`
+	Password          types.String `tfsdk:"password"`
+	PasswordWO        types.String `tfsdk:"password_wo"`
+	PasswordWOVersion types.Int64  `tfsdk:"password_wo_version"`
+	Database          types.String `tfsdk:"database"`
+	TLSEnabled        types.Bool   `tfsdk:"tls_enabled"`
+	TLSSkipVerify     types.Bool   `tfsdk:"tls_skip_verify"`
+}
+
+func (c ClickHouseSharedConfig) ToAPIModel() artieclient.ConnectorConfig {
+	config := artieclient.ConnectorConfig{
+		"host":          c.Host.ValueString(),
+		"port":          c.Port.ValueInt32(),
+		"username":      c.Username.ValueString(),
+		"password":      secretValue(c.Password, c.PasswordWO),
+		"tlsEnabled":    c.TLSEnabled.ValueBool(),
+		"tlsSkipVerify": c.TLSSkipVerify.ValueBool(),
+	}
+	setIfNotZero(config, "database", c.Database.ValueString())
+	return config
+}
+
+func ClickHouseSharedConfigFromAPIModel(apiModel artieclient.ConnectorConfig) *ClickHouseSharedConfig {
+	return &ClickHouseSharedConfig{
+		Host:          types.StringValue(apiModel.String("host")),
+		Port:          types.Int32Value(apiModel.Int32("port")),
+		Username:      types.StringValue(apiModel.String("username")),
+		Database:      types.StringValue(apiModel.String("database")),
+		TLSEnabled:    types.BoolValue(apiModel.Bool("tlsEnabled")),
+		TLSSkipVerify: types.BoolValue(apiModel.Bool("tlsSkipVerify")),
+	}
+}
+
+func (c ClickHouseSharedConfig) Validate(diagnostics *diag.Diagnostics) {
+	if tfmodels.IsExplicitlyTrue(c.TLSSkipVerify) && tfmodels.IsExplicitlyFalse(c.TLSEnabled) {
+		diagnostics.AddError("tls_skip_verify requires TLS", "`tls_skip_verify` can only be set to true inside `clickhouse_config` if `tls_enabled` is also true.")
+	}
+}
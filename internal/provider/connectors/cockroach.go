@@ -0,0 +1,74 @@
+package connectors
+
+import (
+	"math"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-artie/internal/artieclient"
+)
+
+const CockroachDB artieclient.ConnectorType = "cockroach"
+
+func init() {
+	register(Definition{
+		Type:      CockroachDB,
+		Direction: Source,
+		Attributes: map[string]schema.Attribute{
+			"host":          schema.StringAttribute{Required: true, MarkdownDescription: "The hostname of the CockroachDB database."},
+			"snapshot_host": schema.StringAttribute{Optional: true, Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}, MarkdownDescription: "The hostname of the CockroachDB database that we should use to snapshot the database. This can be a read replica and will only be used if this connector is being used as a source. If not provided, we will use the `host` value."},
+			"snapshot_port": schema.Int32Attribute{Optional: true, Computed: true, MarkdownDescription: "The port of the CockroachDB database that we should use to snapshot the database. If not provided, we will use the `port` value."},
+			"port": schema.Int32Attribute{
+				Required:            true,
+				MarkdownDescription: "The default port for CockroachDB is 26257.",
+				Validators: []validator.Int32{
+					int32validator.Between(1024, math.MaxUint16),
+				},
+			},
+			"username": schema.StringAttribute{Required: true, MarkdownDescription: "The username of the service account we will use to connect to the CockroachDB database."},
+			"password": schema.StringAttribute{Required: true, Sensitive: true, MarkdownDescription: "The password of the service account. We recommend storing this in a secret manager and referencing it via a *sensitive* Terraform variable, instead of putting it in plaintext in your Terraform config file."},
+		},
+		NewConfig: func() Config { return &CockroachDBSharedConfig{} },
+		ConfigFromAPIModel: func(apiModel artieclient.ConnectorConfig) Config {
+			return CockroachDBSharedConfigFromAPIModel(apiModel)
+		},
+	})
+}
+
+type CockroachDBSharedConfig struct {
+	Host              types.String `tfsdk:"host"`
+	SnapshotHost      types.String `tfsdk:"snapshot_host"`
+	SnapshotPort      types.Int32  `tfsdk:"snapshot_port"`
+	Port              types.Int32  `tfsdk:"port"`
+	Username          types.String `tfsdk:"username"`
+	Password          types.String `tfsdk:"password"`
+	PasswordWO        types.String `tfsdk:"password_wo"`
+	PasswordWOVersion types.Int64  `tfsdk:"password_wo_version"`
+}
+
+func (c CockroachDBSharedConfig) ToAPIModel() artieclient.ConnectorConfig {
+	config := artieclient.ConnectorConfig{
+		"host":         c.Host.ValueString(),
+		"snapshotHost": c.SnapshotHost.ValueString(),
+		"port":         c.Port.ValueInt32(),
+		"user":         c.Username.ValueString(),
+		"password":     secretValue(c.Password, c.PasswordWO),
+	}
+	setIfNotZero(config, "snapshotPort", c.SnapshotPort.ValueInt32())
+	return config
+}
+
+func CockroachDBSharedConfigFromAPIModel(apiModel artieclient.ConnectorConfig) *CockroachDBSharedConfig {
+	return &CockroachDBSharedConfig{
+		Host:         types.StringValue(apiModel.String("host")),
+		SnapshotHost: types.StringValue(apiModel.String("snapshotHost")),
+		SnapshotPort: types.Int32Value(apiModel.Int32("snapshotPort")),
+		Port:         types.Int32Value(apiModel.Int32("port")),
+		Username:     types.StringValue(apiModel.String("user")),
+	}
+}
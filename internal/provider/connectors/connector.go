@@ -0,0 +1,304 @@
+package connectors
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"terraform-provider-artie/internal/artieclient"
+	"terraform-provider-artie/internal/provider/tfmodels"
+)
+
+// Connector is the Terraform model of the artie_connector resource. Only the settings block of the connector's type
+// is read into Config; use Get and Set instead of the framework's Get and Set to convert it to and from the
+// `<type>_config` attributes.
+type Connector struct {
+	UUID                    types.String
+	SSHTunnelUUID           types.String
+	PrivateLinkUUID         types.String
+	SnapshotPrivateLinkUUID types.String
+	Type                    types.String
+	Name                    types.String
+	DataPlaneName           types.String
+	DefaultDatabase         types.String
+	EnvironmentUUID         types.String
+	IsValid                 types.Bool
+	CreatedAt               types.String
+	UpdatedAt               types.String
+	TestConnectionOnPlan    types.Bool
+	Config                  Config
+}
+
+func (c *Connector) commonAttributes() map[string]*types.String {
+	return map[string]*types.String{
+		"uuid":                       &c.UUID,
+		"ssh_tunnel_uuid":            &c.SSHTunnelUUID,
+		"private_link_uuid":          &c.PrivateLinkUUID,
+		"snapshot_private_link_uuid": &c.SnapshotPrivateLinkUUID,
+		"type":                       &c.Type,
+		"name":                       &c.Name,
+		"data_plane_name":            &c.DataPlaneName,
+		"default_database":           &c.DefaultDatabase,
+		"environment_uuid":           &c.EnvironmentUUID,
+		"created_at":                 &c.CreatedAt,
+		"updated_at":                 &c.UpdatedAt,
+	}
+}
+
+func (c *Connector) boolAttributes() map[string]*types.Bool {
+	return map[string]*types.Bool{
+		"is_valid":                &c.IsValid,
+		"test_connection_on_plan": &c.TestConnectionOnPlan,
+	}
+}
+
+// attributeGetter is implemented by tfsdk.Config, tfsdk.Plan and tfsdk.State.
+type attributeGetter interface {
+	GetAttribute(ctx context.Context, path path.Path, target any) diag.Diagnostics
+}
+
+// attributeSetter is implemented by *tfsdk.State.
+type attributeSetter interface {
+	SetAttribute(ctx context.Context, path path.Path, val any) diag.Diagnostics
+}
+
+// Get reads a connector from a config, plan or state. Config is left nil if the connector's type is unknown or
+// invalid, or if its settings block isn't set.
+func Get(ctx context.Context, source attributeGetter) (Connector, diag.Diagnostics) {
+	var connector Connector
+	var diags diag.Diagnostics
+	for name, value := range connector.commonAttributes() {
+		diags.Append(source.GetAttribute(ctx, path.Root(name), value)...)
+	}
+	for name, value := range connector.boolAttributes() {
+		diags.Append(source.GetAttribute(ctx, path.Root(name), value)...)
+	}
+	if diags.HasError() {
+		return connector, diags
+	}
+
+	definition, err := Lookup(connector.Type.ValueString())
+	if err != nil || !definition.HasConfig() {
+		return connector, diags
+	}
+
+	var block types.Object
+	diags.Append(source.GetAttribute(ctx, path.Root(definition.AttributeName()), &block)...)
+	if diags.HasError() || block.IsNull() {
+		return connector, diags
+	}
+
+	config := definition.NewConfig()
+	diags.Append(block.As(ctx, config, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return connector, diags
+	}
+	connector.Config = config
+	return connector, diags
+}
+
+// GetWithWriteOnly is like Get, but also reads the write-only secrets of the connector from config, since write-only
+// values are always null in plans.
+func GetWithWriteOnly(ctx context.Context, plan attributeGetter, config attributeGetter) (Connector, diag.Diagnostics) {
+	connector, diags := Get(ctx, plan)
+	if diags.HasError() {
+		return connector, diags
+	}
+
+	configData, configDiags := Get(ctx, config)
+	diags.Append(configDiags...)
+	if diags.HasError() {
+		return connector, diags
+	}
+
+	var writeOnly []string
+	if definition, err := Lookup(connector.Type.ValueString()); err == nil {
+		for _, secret := range definition.Secrets() {
+			writeOnly = append(writeOnly, secret+WriteOnlySuffix)
+		}
+	}
+	diags.Append(connector.copyConfigAttributes(ctx, configData, writeOnly)...)
+	return connector, diags
+}
+
+// KeepSecrets copies the secrets and write-only versions from prior (the plan or the prior state) into c. The API
+// doesn't return secrets, so connectors built by ConnectorFromAPIModel don't have them. Write-only values are left
+// null so that they are never stored in state.
+func (c *Connector) KeepSecrets(ctx context.Context, prior Connector) diag.Diagnostics {
+	var names []string
+	if definition, err := Lookup(c.Type.ValueString()); err == nil {
+		for _, secret := range definition.Secrets() {
+			names = append(names, secret, secret+WriteOnlyVersionSuffix)
+		}
+	}
+	return c.copyConfigAttributes(ctx, prior, names)
+}
+
+// copyConfigAttributes copies the settings named names from src's config into c's config. Nothing is copied unless
+// both connectors are of the same type and have settings.
+func (c *Connector) copyConfigAttributes(ctx context.Context, src Connector, names []string) diag.Diagnostics {
+	definition, err := Lookup(c.Type.ValueString())
+	if err != nil || len(names) == 0 || c.Config == nil || src.Config == nil || !c.Type.Equal(src.Type) {
+		return nil
+	}
+
+	attrTypes := definition.attributeTypes()
+	dst, diags := types.ObjectValueFrom(ctx, attrTypes, c.Config)
+	srcObject, srcDiags := types.ObjectValueFrom(ctx, attrTypes, src.Config)
+	diags.Append(srcDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	attributes := dst.Attributes()
+	for _, name := range names {
+		attributes[name] = srcObject.Attributes()[name]
+	}
+	merged, mergedDiags := types.ObjectValue(attrTypes, attributes)
+	diags.Append(mergedDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	config := definition.NewConfig()
+	diags.Append(merged.As(ctx, config, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return diags
+	}
+	c.Config = config
+	return diags
+}
+
+// Set writes connector to a state. The settings blocks of every other connector type are set to null.
+func Set(ctx context.Context, target attributeSetter, connector Connector) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for name, value := range connector.commonAttributes() {
+		diags.Append(target.SetAttribute(ctx, path.Root(name), *value)...)
+	}
+	for name, value := range connector.boolAttributes() {
+		diags.Append(target.SetAttribute(ctx, path.Root(name), *value)...)
+	}
+
+	for _, definition := range All() {
+		if !definition.HasConfig() {
+			continue
+		}
+
+		block := types.ObjectNull(definition.attributeTypes())
+		if string(definition.Type) == connector.Type.ValueString() && connector.Config != nil {
+			var blockDiags diag.Diagnostics
+			block, blockDiags = types.ObjectValueFrom(ctx, definition.attributeTypes(), connector.Config)
+			diags.Append(blockDiags...)
+		}
+		diags.Append(target.SetAttribute(ctx, path.Root(definition.AttributeName()), block)...)
+	}
+	return diags
+}
+
+// Validate checks that the settings block of the connector's type is set and passes the type's own checks.
+func (c Connector) Validate() diag.Diagnostics {
+	var diags diag.Diagnostics
+	definition, err := Lookup(c.Type.ValueString())
+	if err != nil || !definition.HasConfig() {
+		// Invalid types are reported by the schema's validators.
+		return diags
+	}
+
+	if c.Config == nil {
+		diags.AddError(fmt.Sprintf("%s is required", definition.AttributeName()), fmt.Sprintf("Please provide `%s` inside `connector`.", definition.AttributeName()))
+		return diags
+	}
+
+	if config, ok := c.Config.(validatedConfig); ok {
+		config.Validate(&diags)
+	}
+	return diags
+}
+
+func (c Connector) ToAPIBaseModel() (artieclient.BaseConnector, diag.Diagnostics) {
+	definition, err := Lookup(c.Type.ValueString())
+	if err != nil {
+		return artieclient.BaseConnector{}, []diag.Diagnostic{diag.NewErrorDiagnostic(
+			"Unable to convert Connector to API model", err.Error(),
+		)}
+	}
+
+	sharedConfig := artieclient.ConnectorConfig{}
+	if c.Config != nil {
+		sharedConfig = c.Config.ToAPIModel()
+	}
+
+	sshTunnelUUID, diags := tfmodels.ParseOptionalUUID(c.SSHTunnelUUID)
+	privateLinkUUID, privateLinkDiags := tfmodels.ParseOptionalUUID(c.PrivateLinkUUID)
+	diags.Append(privateLinkDiags...)
+	snapshotPrivateLinkUUID, snapshotPrivateLinkDiags := tfmodels.ParseOptionalUUID(c.SnapshotPrivateLinkUUID)
+	diags.Append(snapshotPrivateLinkDiags...)
+	if diags.HasError() {
+		return artieclient.BaseConnector{}, diags
+	}
+
+	return artieclient.BaseConnector{
+		Type:                    definition.Type,
+		DataPlaneName:           c.DataPlaneName.ValueString(),
+		Label:                   c.Name.ValueString(),
+		Config:                  sharedConfig,
+		SSHTunnelUUID:           sshTunnelUUID,
+		PrivateLinkUUID:         privateLinkUUID,
+		SnapshotPrivateLinkUUID: snapshotPrivateLinkUUID,
+		DefaultDatabase:         c.DefaultDatabase.ValueString(),
+	}, diags
+}
+
+func (c Connector) ToAPIModel() (artieclient.Connector, diag.Diagnostics) {
+	baseModel, diags := c.ToAPIBaseModel()
+	if diags.HasError() {
+		return artieclient.Connector{}, diags
+	}
+
+	uuid, uuidDiags := tfmodels.ParseUUID(c.UUID)
+	diags.Append(uuidDiags...)
+	if diags.HasError() {
+		return artieclient.Connector{}, diags
+	}
+
+	return artieclient.Connector{
+		UUID:          uuid,
+		BaseConnector: baseModel,
+	}, diags
+}
+
+// ConnectorFromAPIModel converts a connector returned by the API into its Terraform model. The API doesn't return
+// secrets, so they're left null; use KeepSecrets to fill them in. TestConnectionOnPlan is only a Terraform setting, so
+// it's left null too.
+func ConnectorFromAPIModel(apiModel artieclient.ConnectorDetails) (Connector, diag.Diagnostics) {
+	definition, err := Lookup(string(apiModel.Type))
+	if err != nil {
+		return Connector{}, []diag.Diagnostic{diag.NewErrorDiagnostic(
+			"Unable to convert API model to Connector", err.Error(),
+		)}
+	}
+
+	connector := Connector{
+		UUID:                    types.StringValue(apiModel.UUID.String()),
+		Type:                    types.StringValue(string(apiModel.Type)),
+		DataPlaneName:           types.StringValue(apiModel.DataPlaneName),
+		Name:                    types.StringValue(apiModel.Label),
+		SSHTunnelUUID:           tfmodels.OptionalUUIDToStringValue(apiModel.SSHTunnelUUID),
+		PrivateLinkUUID:         tfmodels.OptionalUUIDToStringValue(apiModel.PrivateLinkUUID),
+		SnapshotPrivateLinkUUID: tfmodels.OptionalUUIDToStringValue(apiModel.SnapshotPrivateLinkUUID),
+		DefaultDatabase:         types.StringValue(apiModel.DefaultDatabase),
+		EnvironmentUUID:         types.StringValue(apiModel.EnvironmentUUID.String()),
+		IsValid:                 types.BoolValue(apiModel.IsValid),
+		CreatedAt:               types.StringValue(apiModel.CreatedAt.Format(time.RFC3339)),
+		UpdatedAt:               types.StringValue(apiModel.UpdatedAt.Format(time.RFC3339)),
+	}
+	if definition.HasConfig() {
+		connector.Config = definition.ConfigFromAPIModel(apiModel.Config)
+	}
+	return connector, nil
+}
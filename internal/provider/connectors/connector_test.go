@@ -0,0 +1,316 @@
+package connectors
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"terraform-provider-artie/internal/artieclient"
+)
+
+var testCreatedAt = time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+// testDetails adds the metadata that the API returns to apiModel.
+func testDetails(apiModel artieclient.Connector) artieclient.ConnectorDetails {
+	return artieclient.ConnectorDetails{
+		Connector:       apiModel,
+		EnvironmentUUID: uuid.MustParse("7f1c2d4e-5a6b-4c8d-9e0f-1a2b3c4d5e6f"),
+		IsValid:         true,
+		CreatedAt:       testCreatedAt,
+		UpdatedAt:       testCreatedAt.Add(time.Hour),
+	}
+}
+
+// withTestMetadata returns connector with the metadata of testDetails.
+func withTestMetadata(connector Connector) Connector {
+	connector.EnvironmentUUID = types.StringValue("7f1c2d4e-5a6b-4c8d-9e0f-1a2b3c4d5e6f")
+	connector.IsValid = types.BoolValue(true)
+	connector.CreatedAt = types.StringValue("2026-01-02T03:04:05Z")
+	connector.UpdatedAt = types.StringValue("2026-01-02T04:04:05Z")
+	return connector
+}
+
+func TestConnector_ClickHouseRoundTrip(t *testing.T) {
+	connector := Connector{
+		UUID:                    types.StringValue(uuid.NewString()),
+		SSHTunnelUUID:           types.StringValue(""),
+		PrivateLinkUUID:         types.StringValue(""),
+		SnapshotPrivateLinkUUID: types.StringValue(""),
+		Type:                    types.StringValue("clickhouse"),
+		Name:                    types.StringValue("Warehouse"),
+		DataPlaneName:           types.StringValue("aws-us-east-1"),
+		DefaultDatabase:         types.StringValue(""),
+		Config: &ClickHouseSharedConfig{
+			Host:          types.StringValue("abc123.us-east-1.aws.clickhouse.cloud"),
+			Port:          types.Int32Value(9440),
+			Username:      types.StringValue("artie"),
+			Password:      types.StringValue("hunter2"),
+			Database:      types.StringValue("analytics"),
+			TLSEnabled:    types.BoolValue(true),
+			TLSSkipVerify: types.BoolValue(false),
+		},
+	}
+
+	apiModel, diags := connector.ToAPIModel()
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, ClickHouse, apiModel.Type)
+	assert.Equal(t, "analytics", apiModel.Config["database"])
+	assert.Equal(t, true, apiModel.Config["tlsEnabled"])
+
+	roundTripped, diags := ConnectorFromAPIModel(testDetails(apiModel))
+	require.False(t, diags.HasError(), diags)
+	diags = roundTripped.KeepSecrets(t.Context(), connector)
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, withTestMetadata(connector), roundTripped)
+}
+
+func TestConnector_MotherDuckRoundTrip(t *testing.T) {
+	connector := Connector{
+		UUID:                    types.StringValue(uuid.NewString()),
+		SSHTunnelUUID:           types.StringValue(""),
+		PrivateLinkUUID:         types.StringValue(""),
+		SnapshotPrivateLinkUUID: types.StringValue(""),
+		Type:                    types.StringValue("motherduck"),
+		Name:                    types.StringValue("Lake"),
+		DataPlaneName:           types.StringValue("aws-us-east-1"),
+		DefaultDatabase:         types.StringValue(""),
+		Config: &MotherDuckSharedConfig{
+			Token:    types.StringValue("md-token"),
+			Database: types.StringValue("analytics"),
+		},
+	}
+
+	apiModel, diags := connector.ToAPIModel()
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, MotherDuck, apiModel.Type)
+	assert.Equal(t, "md-token", apiModel.Config["motherDuckToken"])
+	assert.Equal(t, "analytics", apiModel.Config["database"])
+
+	roundTripped, diags := ConnectorFromAPIModel(testDetails(apiModel))
+	require.False(t, diags.HasError(), diags)
+	diags = roundTripped.KeepSecrets(t.Context(), connector)
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, withTestMetadata(connector), roundTripped)
+}
+
+func TestConnector_PlanetScaleRoundTrip(t *testing.T) {
+	connector := Connector{
+		UUID:                    types.StringValue(uuid.NewString()),
+		SSHTunnelUUID:           types.StringValue(""),
+		PrivateLinkUUID:         types.StringValue(""),
+		SnapshotPrivateLinkUUID: types.StringValue(""),
+		Type:                    types.StringValue("planetscale"),
+		Name:                    types.StringValue("Orders"),
+		DataPlaneName:           types.StringValue("aws-us-east-1"),
+		DefaultDatabase:         types.StringValue(""),
+		Config: &PlanetScaleSharedConfig{
+			Host:     types.StringValue("aws.connect.psdb.cloud"),
+			Port:     types.Int32Value(3306),
+			Username: types.StringValue("artie"),
+			Password: types.StringValue("pscale_pw_hunter2"),
+			Branch:   types.StringValue("main"),
+			Shards:   types.ListValueMust(types.StringType, []attr.Value{types.StringValue("-80"), types.StringValue("80-")}),
+		},
+	}
+
+	apiModel, diags := connector.ToAPIModel()
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, PlanetScale, apiModel.Type)
+	assert.Equal(t, "main", apiModel.Config["branch"])
+	assert.Equal(t, []string{"-80", "80-"}, apiModel.Config["shards"])
+
+	roundTripped, diags := ConnectorFromAPIModel(testDetails(apiModel))
+	require.False(t, diags.HasError(), diags)
+	diags = roundTripped.KeepSecrets(t.Context(), connector)
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, withTestMetadata(connector), roundTripped)
+
+	// Leaving shards unset reads from every shard.
+	connector.Config.(*PlanetScaleSharedConfig).Shards = types.ListNull(types.StringType)
+	apiModel, diags = connector.ToAPIModel()
+	require.False(t, diags.HasError(), diags)
+	assert.NotContains(t, apiModel.Config, "shards")
+
+	roundTripped, diags = ConnectorFromAPIModel(testDetails(apiModel))
+	require.False(t, diags.HasError(), diags)
+	diags = roundTripped.KeepSecrets(t.Context(), connector)
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, withTestMetadata(connector), roundTripped)
+}
+
+func TestConnector_DocumentDBRoundTrip(t *testing.T) {
+	connector := Connector{
+		UUID:                    types.StringValue(uuid.NewString()),
+		SSHTunnelUUID:           types.StringValue(""),
+		PrivateLinkUUID:         types.StringValue(""),
+		SnapshotPrivateLinkUUID: types.StringValue(""),
+		Type:                    types.StringValue("documentdb"),
+		Name:                    types.StringValue("Catalog"),
+		DataPlaneName:           types.StringValue("aws-us-east-1"),
+		DefaultDatabase:         types.StringValue(""),
+		Config: &DocumentDBSharedConfig{
+			Host:        types.StringValue("catalog.cluster-abc123.us-east-1.docdb.amazonaws.com"),
+			Port:        types.Int32Value(27017),
+			Username:    types.StringValue("artie"),
+			Password:    types.StringValue("hunter2"),
+			TLSEnabled:  types.BoolValue(true),
+			TLSCABundle: types.StringValue("-----BEGIN CERTIFICATE-----\n...\n-----END CERTIFICATE-----\n"),
+		},
+	}
+
+	apiModel, diags := connector.ToAPIModel()
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, DocumentDB, apiModel.Type)
+	assert.Equal(t, true, apiModel.Config["tlsEnabled"])
+	assert.Equal(t, connector.Config.(*DocumentDBSharedConfig).TLSCABundle.ValueString(), apiModel.Config["tlsCABundle"])
+
+	roundTripped, diags := ConnectorFromAPIModel(testDetails(apiModel))
+	require.False(t, diags.HasError(), diags)
+	diags = roundTripped.KeepSecrets(t.Context(), connector)
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, withTestMetadata(connector), roundTripped)
+}
+
+func TestConnector_RedisRoundTrip(t *testing.T) {
+	connector := Connector{
+		UUID:                    types.StringValue(uuid.NewString()),
+		SSHTunnelUUID:           types.StringValue(""),
+		PrivateLinkUUID:         types.StringValue(""),
+		SnapshotPrivateLinkUUID: types.StringValue(""),
+		Type:                    types.StringValue("redis"),
+		Name:                    types.StringValue("Sessions"),
+		DataPlaneName:           types.StringValue("aws-us-east-1"),
+		DefaultDatabase:         types.StringValue(""),
+		Config: &RedisSharedConfig{
+			Host:          types.StringValue("sessions.abc123.use1.cache.amazonaws.com"),
+			Port:          types.Int32Value(6379),
+			Username:      types.StringValue("artie"),
+			Password:      types.StringValue("hunter2"),
+			TLSEnabled:    types.BoolValue(true),
+			DatabaseIndex: types.Int32Value(2),
+		},
+	}
+
+	apiModel, diags := connector.ToAPIModel()
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, Redis, apiModel.Type)
+	assert.Equal(t, int32(2), apiModel.Config["databaseIndex"])
+
+	roundTripped, diags := ConnectorFromAPIModel(testDetails(apiModel))
+	require.False(t, diags.HasError(), diags)
+	diags = roundTripped.KeepSecrets(t.Context(), connector)
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, withTestMetadata(connector), roundTripped)
+}
+
+func TestConnector_TLSDisabledIsSent(t *testing.T) {
+	// Turning TLS off is sent explicitly rather than being left out like settings that aren't used.
+	for _, config := range []Config{
+		&ClickHouseSharedConfig{TLSEnabled: types.BoolValue(false), TLSSkipVerify: types.BoolValue(false)},
+		&DocumentDBSharedConfig{TLSEnabled: types.BoolValue(false)},
+		&RedisSharedConfig{TLSEnabled: types.BoolValue(false)},
+	} {
+		apiConfig := config.ToAPIModel()
+		assert.Equal(t, false, apiConfig["tlsEnabled"], "%T", config)
+		if _, ok := config.(*ClickHouseSharedConfig); ok {
+			assert.Equal(t, false, apiConfig["tlsSkipVerify"])
+		}
+	}
+}
+
+func TestConnector_DeltaRoundTrip(t *testing.T) {
+	for _, deltaConfig := range []DeltaSharedConfig{
+		{
+			StorageProvider: types.StringValue("s3"),
+			AccessKeyID:     types.StringValue(""),
+			SecretAccessKey: types.StringValue(""),
+			Region:          types.StringValue("us-east-1"),
+			RoleARN:         types.StringValue("arn:aws:iam::123456789012:role/artie"),
+			ExternalID:      types.StringValue("artie-external-id"),
+			ProjectID:       types.StringValue(""),
+			CredentialsData: types.StringValue(""),
+		},
+		{
+			StorageProvider: types.StringValue("gcs"),
+			AccessKeyID:     types.StringValue(""),
+			SecretAccessKey: types.StringValue(""),
+			Region:          types.StringValue(""),
+			RoleARN:         types.StringValue(""),
+			ExternalID:      types.StringValue(""),
+			ProjectID:       types.StringValue("artie-lake"),
+			CredentialsData: types.StringValue(`{"type": "service_account"}`),
+		},
+	} {
+		connector := Connector{
+			UUID:                    types.StringValue(uuid.NewString()),
+			SSHTunnelUUID:           types.StringValue(""),
+			PrivateLinkUUID:         types.StringValue(""),
+			SnapshotPrivateLinkUUID: types.StringValue(""),
+			Type:                    types.StringValue("delta"),
+			Name:                    types.StringValue("Lake"),
+			DataPlaneName:           types.StringValue("aws-us-east-1"),
+			DefaultDatabase:         types.StringValue(""),
+			Config:                  &deltaConfig,
+		}
+
+		apiModel, diags := connector.ToAPIModel()
+		require.False(t, diags.HasError(), diags)
+		assert.Equal(t, Delta, apiModel.Type)
+		assert.Equal(t, deltaConfig.StorageProvider.ValueString(), apiModel.Config["storageProvider"])
+
+		roundTripped, diags := ConnectorFromAPIModel(testDetails(apiModel))
+		require.False(t, diags.HasError(), diags)
+		diags = roundTripped.KeepSecrets(t.Context(), connector)
+		require.False(t, diags.HasError(), diags)
+		assert.Equal(t, withTestMetadata(connector), roundTripped)
+	}
+}
+
+func TestConnector_PrivateLinkRoundTrip(t *testing.T) {
+	privateLinkUUID := uuid.New()
+	snapshotPrivateLinkUUID := uuid.New()
+	connector := Connector{
+		UUID:                    types.StringValue(uuid.NewString()),
+		SSHTunnelUUID:           types.StringValue(""),
+		PrivateLinkUUID:         types.StringValue(privateLinkUUID.String()),
+		SnapshotPrivateLinkUUID: types.StringValue(snapshotPrivateLinkUUID.String()),
+		Type:                    types.StringValue("motherduck"),
+		Name:                    types.StringValue("Lake"),
+		DataPlaneName:           types.StringValue("aws-us-east-1"),
+		DefaultDatabase:         types.StringValue(""),
+		Config: &MotherDuckSharedConfig{
+			Token:    types.StringValue("md-token"),
+			Database: types.StringValue("analytics"),
+		},
+	}
+
+	apiModel, diags := connector.ToAPIModel()
+	require.False(t, diags.HasError(), diags)
+	assert.Nil(t, apiModel.SSHTunnelUUID)
+	assert.Equal(t, &privateLinkUUID, apiModel.PrivateLinkUUID)
+	assert.Equal(t, &snapshotPrivateLinkUUID, apiModel.SnapshotPrivateLinkUUID)
+
+	roundTripped, diags := ConnectorFromAPIModel(testDetails(apiModel))
+	require.False(t, diags.HasError(), diags)
+	diags = roundTripped.KeepSecrets(t.Context(), connector)
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, withTestMetadata(connector), roundTripped)
+
+	{
+		// Clearing the PrivateLink sends a null UUID to the API.
+		connector.PrivateLinkUUID = types.StringValue("")
+		apiModel, diags := connector.ToAPIModel()
+		require.False(t, diags.HasError(), diags)
+		assert.Nil(t, apiModel.PrivateLinkUUID)
+	}
+	{
+		connector.SnapshotPrivateLinkUUID = types.StringValue("not-a-uuid")
+		_, diags := connector.ToAPIModel()
+		assert.True(t, diags.HasError())
+	}
+}
@@ -0,0 +1,79 @@
+package connectors
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-artie/internal/artieclient"
+)
+
+const Databricks artieclient.ConnectorType = "databricks"
+
+func init() {
+	register(Definition{
+		Type:        Databricks,
+		Direction:   Destination,
+		Description: "Exactly one authentication method must be configured: either `personal_access_token` alone, or both `client_id` and `client_secret` together (OAuth M2M).",
+		Attributes: map[string]schema.Attribute{
+			"host":                  schema.StringAttribute{Required: true, MarkdownDescription: "The hostname of the Databricks cluster."},
+			"http_path":             schema.StringAttribute{Required: true, MarkdownDescription: "The HTTP path of the Databricks cluster."},
+			"personal_access_token": schema.StringAttribute{Optional: true, Computed: true, Sensitive: true, Default: stringdefault.StaticString(""), MarkdownDescription: "The personal access token for the service account we should use to connect to Databricks. Conflicts with `client_id` and `client_secret`."},
+			"client_id":             schema.StringAttribute{Optional: true, Computed: true, Default: stringdefault.StaticString(""), MarkdownDescription: "The OAuth M2M client ID for authenticating with Databricks. Must be provided together with `client_secret`. Conflicts with `personal_access_token`."},
+			"client_secret":         schema.StringAttribute{Optional: true, Computed: true, Sensitive: true, Default: stringdefault.StaticString(""), MarkdownDescription: "The OAuth M2M client secret for authenticating with Databricks. Must be provided together with `client_id`. Conflicts with `personal_access_token`."},
+			"volume":                schema.StringAttribute{Required: true, MarkdownDescription: "The volume of the Databricks cluster."},
+		},
+		NewConfig:          func() Config { return &DatabricksSharedConfig{} },
+		ConfigFromAPIModel: func(apiModel artieclient.ConnectorConfig) Config { return DatabricksSharedConfigFromAPIModel(apiModel) },
+	})
+}
+
+type DatabricksSharedConfig struct {
+	Host                         types.String `tfsdk:"host"`
+	HttpPath                     types.String `tfsdk:"http_path"`
+	PersonalAccessToken          types.String `tfsdk:"personal_access_token"`
+	PersonalAccessTokenWO        types.String `tfsdk:"personal_access_token_wo"`
+	PersonalAccessTokenWOVersion types.Int64  `tfsdk:"personal_access_token_wo_version"`
+	ClientID                     types.String `tfsdk:"client_id"`
+	ClientSecret                 types.String `tfsdk:"client_secret"`
+	ClientSecretWO               types.String `tfsdk:"client_secret_wo"`
+	ClientSecretWOVersion        types.Int64  `tfsdk:"client_secret_wo_version"`
+	Volume                       types.String `tfsdk:"volume"`
+}
+
+func (d DatabricksSharedConfig) ToAPIModel() artieclient.ConnectorConfig {
+	return artieclient.ConnectorConfig{
+		"host":                d.Host.ValueString(),
+		"httpPath":            d.HttpPath.ValueString(),
+		"personalAccessToken": secretValue(d.PersonalAccessToken, d.PersonalAccessTokenWO),
+		"clientID":            d.ClientID.ValueString(),
+		"clientSecret":        secretValue(d.ClientSecret, d.ClientSecretWO),
+		"volume":              d.Volume.ValueString(),
+	}
+}
+
+func DatabricksSharedConfigFromAPIModel(apiModel artieclient.ConnectorConfig) *DatabricksSharedConfig {
+	return &DatabricksSharedConfig{
+		Host:     types.StringValue(apiModel.String("host")),
+		HttpPath: types.StringValue(apiModel.String("httpPath")),
+		ClientID: types.StringValue(apiModel.String("clientID")),
+		Volume:   types.StringValue(apiModel.String("volume")),
+	}
+}
+
+func (d DatabricksSharedConfig) Validate(diagnostics *diag.Diagnostics) {
+	patSet := !d.PersonalAccessToken.IsNull() || !d.PersonalAccessTokenWO.IsNull()
+	clientIDSet := !d.ClientID.IsNull()
+	clientSecretSet := !d.ClientSecret.IsNull() || !d.ClientSecretWO.IsNull()
+
+	if patSet && (clientIDSet || clientSecretSet) {
+		diagnostics.AddError("Conflicting authentication methods", "`personal_access_token` conflicts with `client_id` and `client_secret`. Please provide either `personal_access_token` or both `client_id` and `client_secret`, not both.")
+	}
+	if !patSet && !clientIDSet && !clientSecretSet {
+		diagnostics.AddError("Authentication is required", "Please provide either `personal_access_token` or both `client_id` and `client_secret` inside `databricks_config`.")
+	}
+	if clientIDSet != clientSecretSet {
+		diagnostics.AddError("Incomplete OAuth M2M configuration", "Both `client_id` and `client_secret` must be provided together inside `databricks_config`.")
+	}
+}
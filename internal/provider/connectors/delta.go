@@ -0,0 +1,107 @@
+package connectors
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-artie/internal/artieclient"
+	"terraform-provider-artie/internal/provider/tfmodels"
+)
+
+const Delta artieclient.ConnectorType = "delta"
+
+func init() {
+	register(Definition{
+		Type:        Delta,
+		Direction:   Destination,
+		Description: "The `storage_provider` field determines which additional fields are required: for `s3`, provide `region` and either `role_arn` or both `access_key_id` and `secret_access_key`; for `gcs`, provide `project_id` and `credentials_data`.",
+		Attributes: map[string]schema.Attribute{
+			"storage_provider": schema.StringAttribute{Required: true, MarkdownDescription: "Where the Delta tables are stored. Must be `s3` or `gcs`.", Validators: []validator.String{stringvalidator.OneOf("s3", "gcs")}},
+
+			// S3 fields:
+			"access_key_id":     schema.StringAttribute{Optional: true, Computed: true, Default: stringdefault.StaticString(""), MarkdownDescription: "The AWS Access Key ID for the service account we should use to write to S3. Required if `storage_provider` is `s3` and `role_arn` is not set."},
+			"secret_access_key": schema.StringAttribute{Optional: true, Computed: true, Sensitive: true, Default: stringdefault.StaticString(""), MarkdownDescription: "The AWS Secret Access Key for the service account we should use to write to S3. Required if `storage_provider` is `s3` and `role_arn` is not set. We recommend storing this in a secret manager and referencing it via a *sensitive* Terraform variable, instead of putting it in plaintext in your Terraform config file."},
+			"region":            schema.StringAttribute{Optional: true, Computed: true, Default: stringdefault.StaticString(""), MarkdownDescription: "The AWS region of the S3 bucket. Required if `storage_provider` is `s3`."},
+			"role_arn":          schema.StringAttribute{Optional: true, Computed: true, Default: stringdefault.StaticString(""), MarkdownDescription: "The ARN of the IAM role to assume for writing to S3. If set, `access_key_id` and `secret_access_key` are not required."},
+			"external_id":       schema.StringAttribute{Optional: true, Computed: true, Default: stringdefault.StaticString(""), MarkdownDescription: "The external ID to use when assuming the IAM role. Only applicable when `role_arn` is set."},
+
+			// GCS fields:
+			"project_id":       schema.StringAttribute{Optional: true, Computed: true, Default: stringdefault.StaticString(""), MarkdownDescription: "The ID of the Google Cloud project. Required if `storage_provider` is `gcs`."},
+			"credentials_data": schema.StringAttribute{Optional: true, Computed: true, Sensitive: true, Default: stringdefault.StaticString(""), MarkdownDescription: "The credentials data for the Google Cloud service account that we should use to write to GCS. Required if `storage_provider` is `gcs`. We recommend storing this in a secret manager and referencing it via a *sensitive* Terraform variable, instead of putting it in plaintext in your Terraform config file."},
+		},
+		NewConfig:          func() Config { return &DeltaSharedConfig{} },
+		ConfigFromAPIModel: func(apiModel artieclient.ConnectorConfig) Config { return DeltaSharedConfigFromAPIModel(apiModel) },
+	})
+}
+
+type DeltaSharedConfig struct {
+	StorageProvider types.String `tfsdk:"storage_provider"`
+
+	// S3 fields:
+	AccessKeyID              types.String `tfsdk:"access_key_id"`
+	SecretAccessKey          types.String `tfsdk:"secret_access_key"`
+	SecretAccessKeyWO        types.String `tfsdk:"secret_access_key_wo"`
+	SecretAccessKeyWOVersion types.Int64  `tfsdk:"secret_access_key_wo_version"`
+	Region                   types.String `tfsdk:"region"`
+	RoleARN                  types.String `tfsdk:"role_arn"`
+	ExternalID               types.String `tfsdk:"external_id"`
+
+	// GCS fields:
+	ProjectID                types.String `tfsdk:"project_id"`
+	CredentialsData          types.String `tfsdk:"credentials_data"`
+	CredentialsDataWO        types.String `tfsdk:"credentials_data_wo"`
+	CredentialsDataWOVersion types.Int64  `tfsdk:"credentials_data_wo_version"`
+}
+
+func (d DeltaSharedConfig) ToAPIModel() artieclient.ConnectorConfig {
+	config := artieclient.ConnectorConfig{
+		"awsAccessKeyID":     d.AccessKeyID.ValueString(),
+		"awsSecretAccessKey": secretValue(d.SecretAccessKey, d.SecretAccessKeyWO),
+		"awsRegion":          d.Region.ValueString(),
+		"awsRoleARN":         d.RoleARN.ValueString(),
+		"awsExternalID":      d.ExternalID.ValueString(),
+		"projectID":          d.ProjectID.ValueString(),
+		"credentialsData":    secretValue(d.CredentialsData, d.CredentialsDataWO),
+	}
+	setIfNotZero(config, "storageProvider", d.StorageProvider.ValueString())
+	return config
+}
+
+func DeltaSharedConfigFromAPIModel(apiModel artieclient.ConnectorConfig) *DeltaSharedConfig {
+	return &DeltaSharedConfig{
+		StorageProvider: types.StringValue(apiModel.String("storageProvider")),
+		AccessKeyID:     types.StringValue(apiModel.String("awsAccessKeyID")),
+		Region:          types.StringValue(apiModel.String("awsRegion")),
+		RoleARN:         types.StringValue(apiModel.String("awsRoleARN")),
+		ExternalID:      types.StringValue(apiModel.String("awsExternalID")),
+		ProjectID:       types.StringValue(apiModel.String("projectID")),
+	}
+}
+
+func (d DeltaSharedConfig) Validate(diagnostics *diag.Diagnostics) {
+	switch d.StorageProvider.ValueString() {
+	case "s3":
+		if tfmodels.IsKnownAndEmpty(d.Region) {
+			diagnostics.AddError("region is required for s3", "Please provide `region` inside `delta_config` when using the `s3` storage provider.")
+		}
+		if tfmodels.IsKnownAndEmpty(d.RoleARN) {
+			if tfmodels.IsKnownAndEmpty(d.AccessKeyID) {
+				diagnostics.AddError("access_key_id is required", "Please provide `access_key_id` inside `delta_config`, or set `role_arn` to use IAM role assumption instead.")
+			}
+			if secretIsEmpty(d.SecretAccessKey, d.SecretAccessKeyWO) {
+				diagnostics.AddError("secret_access_key is required", "Please provide `secret_access_key` inside `delta_config`, or set `role_arn` to use IAM role assumption instead.")
+			}
+		}
+	case "gcs":
+		if tfmodels.IsKnownAndEmpty(d.ProjectID) {
+			diagnostics.AddError("project_id is required for gcs", "Please provide `project_id` inside `delta_config` when using the `gcs` storage provider.")
+		}
+		if secretIsEmpty(d.CredentialsData, d.CredentialsDataWO) {
+			diagnostics.AddError("credentials_data is required for gcs", "Please provide `credentials_data` inside `delta_config` when using the `gcs` storage provider.")
+		}
+	}
+}
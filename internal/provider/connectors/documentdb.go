@@ -0,0 +1,93 @@
+package connectors
+
+import (
+	"math"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-artie/internal/artieclient"
+	"terraform-provider-artie/internal/provider/tfmodels"
+)
+
+const DocumentDB artieclient.ConnectorType = "documentdb"
+
+func init() {
+	register(Definition{
+		Type:      DocumentDB,
+		Direction: Source,
+		Attributes: map[string]schema.Attribute{
+			"host": schema.StringAttribute{Required: true, MarkdownDescription: "The cluster endpoint of the Amazon DocumentDB cluster, e.g. `my-cluster.cluster-abc123.us-east-1.docdb.amazonaws.com`."},
+			"port": schema.Int32Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int32default.StaticInt32(27017),
+				MarkdownDescription: "The port of the DocumentDB cluster. This defaults to 27017.",
+				Validators: []validator.Int32{
+					int32validator.Between(1024, math.MaxUint16),
+				},
+			},
+			"username": schema.StringAttribute{Required: true, MarkdownDescription: "The username of the service account we will use to connect to the DocumentDB cluster."},
+			"password": schema.StringAttribute{Required: true, Sensitive: true, MarkdownDescription: "The password of the service account we will use to connect to the DocumentDB cluster. We recommend storing this in a secret manager and referencing it via a *sensitive* Terraform variable, instead of putting it in plaintext in your Terraform config file."},
+			"tls_enabled": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Whether we should connect to the cluster over TLS. DocumentDB clusters require TLS unless it has been disabled in the cluster's parameter group. This defaults to true.",
+			},
+			"tls_ca_bundle": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				MarkdownDescription: "A PEM-encoded CA bundle that we should use to verify the cluster's certificate. If not set, we will use the Amazon RDS global certificate bundle. This is only applicable if `tls_enabled` is true.",
+			},
+		},
+		NewConfig:          func() Config { return &DocumentDBSharedConfig{} },
+		ConfigFromAPIModel: func(apiModel artieclient.ConnectorConfig) Config { return DocumentDBSharedConfigFromAPIModel(apiModel) },
+	})
+}
+
+type DocumentDBSharedConfig struct {
+	Host              types.String `tfsdk:"host"`
+	Port              types.Int32  `tfsdk:"port"`
+	Username          types.String `tfsdk:"username"`
+	Password          types.String `tfsdk:"password"`
+	PasswordWO        types.String `tfsdk:"password_wo"`
+	PasswordWOVersion types.Int64  `tfsdk:"password_wo_version"`
+	TLSEnabled        types.Bool   `tfsdk:"tls_enabled"`
+	TLSCABundle       types.String `tfsdk:"tls_ca_bundle"`
+}
+
+func (d DocumentDBSharedConfig) ToAPIModel() artieclient.ConnectorConfig {
+	config := artieclient.ConnectorConfig{
+		"host":       d.Host.ValueString(),
+		"port":       d.Port.ValueInt32(),
+		"user":       d.Username.ValueString(),
+		"password":   secretValue(d.Password, d.PasswordWO),
+		"tlsEnabled": d.TLSEnabled.ValueBool(),
+	}
+	setIfNotZero(config, "tlsCABundle", d.TLSCABundle.ValueString())
+	return config
+}
+
+func DocumentDBSharedConfigFromAPIModel(apiModel artieclient.ConnectorConfig) *DocumentDBSharedConfig {
+	return &DocumentDBSharedConfig{
+		Host:        types.StringValue(apiModel.String("host")),
+		Port:        types.Int32Value(apiModel.Int32("port")),
+		Username:    types.StringValue(apiModel.String("user")),
+		TLSEnabled:  types.BoolValue(apiModel.Bool("tlsEnabled")),
+		TLSCABundle: types.StringValue(apiModel.String("tlsCABundle")),
+	}
+}
+
+func (d DocumentDBSharedConfig) Validate(diagnostics *diag.Diagnostics) {
+	if tfmodels.IsExplicitlyFalse(d.TLSEnabled) && tfmodels.IsKnownAndNonEmpty(d.TLSCABundle) {
+		diagnostics.AddError("tls_ca_bundle requires TLS", "Please remove `tls_ca_bundle` inside `documentdb_config`, or set `tls_enabled` to true.")
+	}
+}
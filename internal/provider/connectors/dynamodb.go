@@ -0,0 +1,70 @@
+package connectors
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-artie/internal/artieclient"
+	"terraform-provider-artie/internal/provider/tfmodels"
+)
+
+const DynamoDB artieclient.ConnectorType = "dynamodb"
+
+func init() {
+	register(Definition{
+		Type:        DynamoDB,
+		Direction:   Source,
+		Description: "You must provide either `role_arn` (for IAM role assumption) or both `access_key_id` and `secret_access_key` (for static credentials).",
+		Attributes: map[string]schema.Attribute{
+			"stream_arn":        schema.StringAttribute{Required: true, MarkdownDescription: "The ARN (Amazon Resource Name) of the DynamoDB Stream."},
+			"access_key_id":     schema.StringAttribute{Optional: true, Computed: true, Default: stringdefault.StaticString(""), MarkdownDescription: "The AWS Access Key ID for the service account we should use to connect to DynamoDB. Required if `role_arn` is not set."},
+			"secret_access_key": schema.StringAttribute{Optional: true, Computed: true, Sensitive: true, Default: stringdefault.StaticString(""), MarkdownDescription: "The AWS Secret Access Key for the service account we should use to connect to DynamoDB. Required if `role_arn` is not set. We recommend storing this in a secret manager and referencing it via a *sensitive* Terraform variable, instead of putting it in plaintext in your Terraform config file."},
+			"role_arn":          schema.StringAttribute{Optional: true, Computed: true, Default: stringdefault.StaticString(""), MarkdownDescription: "The ARN of the IAM role to assume for connecting to DynamoDB. If set, `access_key_id` and `secret_access_key` are not required."},
+			"external_id":       schema.StringAttribute{Optional: true, Computed: true, Default: stringdefault.StaticString(""), MarkdownDescription: "The external ID to use when assuming the IAM role. Only applicable when `role_arn` is set."},
+		},
+		NewConfig:          func() Config { return &DynamoDBConfig{} },
+		ConfigFromAPIModel: func(apiModel artieclient.ConnectorConfig) Config { return DynamoDBConfigFromAPIModel(apiModel) },
+	})
+}
+
+type DynamoDBConfig struct {
+	StreamArn                   types.String `tfsdk:"stream_arn"`
+	AwsAccessKeyID              types.String `tfsdk:"access_key_id"`
+	AwsSecretAccessKey          types.String `tfsdk:"secret_access_key"`
+	AwsSecretAccessKeyWO        types.String `tfsdk:"secret_access_key_wo"`
+	AwsSecretAccessKeyWOVersion types.Int64  `tfsdk:"secret_access_key_wo_version"`
+	RoleARN                     types.String `tfsdk:"role_arn"`
+	ExternalID                  types.String `tfsdk:"external_id"`
+}
+
+func (d DynamoDBConfig) ToAPIModel() artieclient.ConnectorConfig {
+	return artieclient.ConnectorConfig{
+		"streamsArn":         d.StreamArn.ValueString(),
+		"awsAccessKeyID":     d.AwsAccessKeyID.ValueString(),
+		"awsSecretAccessKey": secretValue(d.AwsSecretAccessKey, d.AwsSecretAccessKeyWO),
+		"awsRoleARN":         d.RoleARN.ValueString(),
+		"awsExternalID":      d.ExternalID.ValueString(),
+	}
+}
+
+func DynamoDBConfigFromAPIModel(apiModel artieclient.ConnectorConfig) *DynamoDBConfig {
+	return &DynamoDBConfig{
+		StreamArn:      types.StringValue(apiModel.String("streamsArn")),
+		AwsAccessKeyID: types.StringValue(apiModel.String("awsAccessKeyID")),
+		RoleARN:        types.StringValue(apiModel.String("awsRoleARN")),
+		ExternalID:     types.StringValue(apiModel.String("awsExternalID")),
+	}
+}
+
+func (d DynamoDBConfig) Validate(diagnostics *diag.Diagnostics) {
+	if tfmodels.IsKnownAndEmpty(d.RoleARN) {
+		if tfmodels.IsKnownAndEmpty(d.AwsAccessKeyID) {
+			diagnostics.AddError("access_key_id is required", "Please provide `access_key_id` inside `dynamodb_config`, or set `role_arn` to use IAM role assumption instead.")
+		}
+		if secretIsEmpty(d.AwsSecretAccessKey, d.AwsSecretAccessKeyWO) {
+			diagnostics.AddError("secret_access_key is required", "Please provide `secret_access_key` inside `dynamodb_config`, or set `role_arn` to use IAM role assumption instead.")
+		}
+	}
+}
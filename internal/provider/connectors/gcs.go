@@ -0,0 +1,43 @@
+package connectors
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-artie/internal/artieclient"
+)
+
+const GCS artieclient.ConnectorType = "gcs"
+
+func init() {
+	register(Definition{
+		Type:      GCS,
+		Direction: Destination,
+		Attributes: map[string]schema.Attribute{
+			"project_id":       schema.StringAttribute{Required: true, MarkdownDescription: "The ID of the Google Cloud project."},
+			"credentials_data": schema.StringAttribute{Required: true, Sensitive: true, MarkdownDescription: "The credentials data for the Google Cloud service account that we should use to connect to GCS. We recommend storing this in a secret manager and referencing it via a *sensitive* Terraform variable, instead of putting it in plaintext in your Terraform config file."},
+		},
+		NewConfig:          func() Config { return &GCSSharedConfig{} },
+		ConfigFromAPIModel: func(apiModel artieclient.ConnectorConfig) Config { return GCSSharedConfigFromAPIModel(apiModel) },
+	})
+}
+
+type GCSSharedConfig struct {
+	ProjectID                types.String `tfsdk:"project_id"`
+	CredentialsData          types.String `tfsdk:"credentials_data"`
+	CredentialsDataWO        types.String `tfsdk:"credentials_data_wo"`
+	CredentialsDataWOVersion types.Int64  `tfsdk:"credentials_data_wo_version"`
+}
+
+func (g GCSSharedConfig) ToAPIModel() artieclient.ConnectorConfig {
+	return artieclient.ConnectorConfig{
+		"projectID":       g.ProjectID.ValueString(),
+		"credentialsData": secretValue(g.CredentialsData, g.CredentialsDataWO),
+	}
+}
+
+func GCSSharedConfigFromAPIModel(apiModel artieclient.ConnectorConfig) *GCSSharedConfig {
+	return &GCSSharedConfig{
+		ProjectID: types.StringValue(apiModel.String("projectID")),
+	}
+}
@@ -0,0 +1,128 @@
+package connectors
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-artie/internal/artieclient"
+	"terraform-provider-artie/internal/provider/tfmodels"
+)
+
+const Iceberg artieclient.ConnectorType = "iceberg"
+
+func init() {
+	register(Definition{
+		Type:        Iceberg,
+		Direction:   Destination,
+		Description: "The `provider` field determines which additional fields are required: for `s3tables`, provide AWS credentials and bucket ARN; for `rest`, provide the catalog URI, warehouse, and authentication credentials.",
+		Attributes: map[string]schema.Attribute{
+			"provider": schema.StringAttribute{Required: true, MarkdownDescription: "The Iceberg provider type. Must be `s3tables` or `rest`.", Validators: []validator.String{stringvalidator.OneOf("s3tables", "rest")}},
+
+			// S3 Tables fields:
+			"access_key_id":     schema.StringAttribute{Optional: true, Computed: true, Default: stringdefault.StaticString(""), MarkdownDescription: "The AWS Access Key ID for connecting to S3 Tables. Required if `provider` is `s3tables`."},
+			"secret_access_key": schema.StringAttribute{Optional: true, Computed: true, Sensitive: true, Default: stringdefault.StaticString(""), MarkdownDescription: "The AWS Secret Access Key for connecting to S3 Tables. Required if `provider` is `s3tables`. We recommend storing this in a secret manager and referencing it via a *sensitive* Terraform variable."},
+			"bucket_arn":        schema.StringAttribute{Optional: true, Computed: true, Default: stringdefault.StaticString(""), MarkdownDescription: "The ARN of the S3 Tables table bucket (e.g. `arn:aws:s3tables:us-east-1:123456789012:bucket/my-bucket`). Required if `provider` is `s3tables`."},
+			"region":            schema.StringAttribute{Optional: true, Computed: true, Default: stringdefault.StaticString(""), MarkdownDescription: "The AWS region for S3 Tables. Optional; can be parsed from the `bucket_arn`."},
+
+			// REST Catalog fields:
+			"uri":        schema.StringAttribute{Optional: true, Computed: true, Default: stringdefault.StaticString(""), MarkdownDescription: "The REST catalog endpoint URL. Required if `provider` is `rest`."},
+			"token":      schema.StringAttribute{Optional: true, Computed: true, Sensitive: true, Default: stringdefault.StaticString(""), MarkdownDescription: "A bearer token for authenticating with the REST catalog. Either `token` or `credential` must be provided if `provider` is `rest`."},
+			"credential": schema.StringAttribute{Optional: true, Computed: true, Sensitive: true, Default: stringdefault.StaticString(""), MarkdownDescription: "OAuth2 client credentials in the format `client_id:client_secret` for authenticating with the REST catalog. Either `token` or `credential` must be provided if `provider` is `rest`."},
+			"auth_uri":   schema.StringAttribute{Optional: true, Computed: true, Default: stringdefault.StaticString(""), MarkdownDescription: "The OAuth2 token endpoint URL. Required when using `credential` authentication (without `token`)."},
+			"scope":      schema.StringAttribute{Optional: true, Computed: true, Default: stringdefault.StaticString(""), MarkdownDescription: "The OAuth2 scope. Optional; defaults to `catalog` on the server side."},
+			"warehouse":  schema.StringAttribute{Optional: true, Computed: true, Default: stringdefault.StaticString(""), MarkdownDescription: "The warehouse identifier for the REST catalog. Required if `provider` is `rest`."},
+			"prefix":     schema.StringAttribute{Optional: true, Computed: true, Default: stringdefault.StaticString(""), MarkdownDescription: "An optional catalog prefix for namespacing in the REST catalog."},
+		},
+		NewConfig:          func() Config { return &IcebergSharedConfig{} },
+		ConfigFromAPIModel: func(apiModel artieclient.ConnectorConfig) Config { return IcebergSharedConfigFromAPIModel(apiModel) },
+	})
+}
+
+type IcebergSharedConfig struct {
+	Provider types.String `tfsdk:"provider"`
+
+	// S3 Tables fields:
+	AwsAccessKeyID              types.String `tfsdk:"access_key_id"`
+	AwsSecretAccessKey          types.String `tfsdk:"secret_access_key"`
+	AwsSecretAccessKeyWO        types.String `tfsdk:"secret_access_key_wo"`
+	AwsSecretAccessKeyWOVersion types.Int64  `tfsdk:"secret_access_key_wo_version"`
+	BucketARN                   types.String `tfsdk:"bucket_arn"`
+	Region                      types.String `tfsdk:"region"`
+
+	// REST Catalog fields:
+	URI                 types.String `tfsdk:"uri"`
+	Token               types.String `tfsdk:"token"`
+	TokenWO             types.String `tfsdk:"token_wo"`
+	TokenWOVersion      types.Int64  `tfsdk:"token_wo_version"`
+	Credential          types.String `tfsdk:"credential"`
+	CredentialWO        types.String `tfsdk:"credential_wo"`
+	CredentialWOVersion types.Int64  `tfsdk:"credential_wo_version"`
+	AuthURI             types.String `tfsdk:"auth_uri"`
+	Scope               types.String `tfsdk:"scope"`
+	Warehouse           types.String `tfsdk:"warehouse"`
+	Prefix              types.String `tfsdk:"prefix"`
+}
+
+func (i IcebergSharedConfig) ToAPIModel() artieclient.ConnectorConfig {
+	config := artieclient.ConnectorConfig{
+		"provider":           i.Provider.ValueString(),
+		"awsAccessKeyID":     i.AwsAccessKeyID.ValueString(),
+		"awsSecretAccessKey": secretValue(i.AwsSecretAccessKey, i.AwsSecretAccessKeyWO),
+		"bucketARN":          i.BucketARN.ValueString(),
+	}
+	setIfNotZero(config, "region", i.Region.ValueString())
+	setIfNotZero(config, "uri", i.URI.ValueString())
+	setIfNotZero(config, "token", secretValue(i.Token, i.TokenWO))
+	setIfNotZero(config, "credential", secretValue(i.Credential, i.CredentialWO))
+	setIfNotZero(config, "authURI", i.AuthURI.ValueString())
+	setIfNotZero(config, "scope", i.Scope.ValueString())
+	setIfNotZero(config, "warehouse", i.Warehouse.ValueString())
+	setIfNotZero(config, "prefix", i.Prefix.ValueString())
+	return config
+}
+
+func IcebergSharedConfigFromAPIModel(apiModel artieclient.ConnectorConfig) *IcebergSharedConfig {
+	return &IcebergSharedConfig{
+		Provider:       types.StringValue(apiModel.String("provider")),
+		AwsAccessKeyID: types.StringValue(apiModel.String("awsAccessKeyID")),
+		BucketARN:      types.StringValue(apiModel.String("bucketARN")),
+		Region:         types.StringValue(apiModel.String("region")),
+		URI:            types.StringValue(apiModel.String("uri")),
+		AuthURI:        types.StringValue(apiModel.String("authURI")),
+		Scope:          types.StringValue(apiModel.String("scope")),
+		Warehouse:      types.StringValue(apiModel.String("warehouse")),
+		Prefix:         types.StringValue(apiModel.String("prefix")),
+	}
+}
+
+func (i IcebergSharedConfig) Validate(diagnostics *diag.Diagnostics) {
+	switch i.Provider.ValueString() {
+	case "s3tables":
+		if tfmodels.IsKnownAndEmpty(i.AwsAccessKeyID) {
+			diagnostics.AddError("access_key_id is required for s3tables", "Please provide `access_key_id` inside `iceberg_config` when using `s3tables` provider.")
+		}
+		if secretIsEmpty(i.AwsSecretAccessKey, i.AwsSecretAccessKeyWO) {
+			diagnostics.AddError("secret_access_key is required for s3tables", "Please provide `secret_access_key` inside `iceberg_config` when using `s3tables` provider.")
+		}
+		if tfmodels.IsKnownAndEmpty(i.BucketARN) {
+			diagnostics.AddError("bucket_arn is required for s3tables", "Please provide `bucket_arn` inside `iceberg_config` when using `s3tables` provider.")
+		}
+	case "rest":
+		if tfmodels.IsKnownAndEmpty(i.URI) {
+			diagnostics.AddError("uri is required for rest catalog", "Please provide `uri` inside `iceberg_config` when using `rest` provider.")
+		}
+		if tfmodels.IsKnownAndEmpty(i.Warehouse) {
+			diagnostics.AddError("warehouse is required for rest catalog", "Please provide `warehouse` inside `iceberg_config` when using `rest` provider.")
+		}
+		if secretIsEmpty(i.Token, i.TokenWO) && secretIsEmpty(i.Credential, i.CredentialWO) {
+			diagnostics.AddError("token or credential is required for rest catalog", "Please provide either `token` or `credential` inside `iceberg_config` when using `rest` provider.")
+		}
+		if secretIsEmpty(i.Token, i.TokenWO) && (tfmodels.IsKnownAndNonEmpty(i.Credential) || tfmodels.IsKnownAndNonEmpty(i.CredentialWO)) && tfmodels.IsKnownAndEmpty(i.AuthURI) {
+			diagnostics.AddError("auth_uri is required when using credential", "Please provide `auth_uri` inside `iceberg_config` when using `credential` authentication without `token`.")
+		}
+	}
+}
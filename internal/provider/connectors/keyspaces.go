@@ -0,0 +1,88 @@
+package connectors
+
+import (
+	"math"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-artie/internal/artieclient"
+	"terraform-provider-artie/internal/provider/tfmodels"
+)
+
+const Keyspaces artieclient.ConnectorType = "keyspaces"
+
+func init() {
+	register(Definition{
+		Type:        Keyspaces,
+		Direction:   Source,
+		Description: "You must provide either `role_arn` (for IAM role assumption) or both `access_key_id` and `secret_access_key` (for static credentials).",
+		Attributes: map[string]schema.Attribute{
+			"host": schema.StringAttribute{Required: true, MarkdownDescription: "The hostname of the Amazon Keyspaces endpoint."},
+			"port": schema.Int32Attribute{
+				Required:            true,
+				MarkdownDescription: "The default port for Amazon Keyspaces is 9142.",
+				Validators: []validator.Int32{
+					int32validator.Between(1024, math.MaxUint16),
+				},
+			},
+			"region":            schema.StringAttribute{Required: true, MarkdownDescription: "The AWS region of the Amazon Keyspaces instance."},
+			"access_key_id":     schema.StringAttribute{Optional: true, Computed: true, Default: stringdefault.StaticString(""), MarkdownDescription: "The AWS Access Key ID for connecting to Amazon Keyspaces. Required if `role_arn` is not set."},
+			"secret_access_key": schema.StringAttribute{Optional: true, Computed: true, Sensitive: true, Default: stringdefault.StaticString(""), MarkdownDescription: "The AWS Secret Access Key for connecting to Amazon Keyspaces. Required if `role_arn` is not set. We recommend storing this in a secret manager and referencing it via a *sensitive* Terraform variable, instead of putting it in plaintext in your Terraform config file."},
+			"role_arn":          schema.StringAttribute{Optional: true, Computed: true, Default: stringdefault.StaticString(""), MarkdownDescription: "The ARN of the IAM role to assume for connecting to Amazon Keyspaces. If set, `access_key_id` and `secret_access_key` are not required."},
+			"external_id":       schema.StringAttribute{Optional: true, Computed: true, Default: stringdefault.StaticString(""), MarkdownDescription: "The external ID to use when assuming the IAM role. Only applicable when `role_arn` is set."},
+		},
+		NewConfig:          func() Config { return &KeyspacesSharedConfig{} },
+		ConfigFromAPIModel: func(apiModel artieclient.ConnectorConfig) Config { return KeyspacesSharedConfigFromAPIModel(apiModel) },
+	})
+}
+
+type KeyspacesSharedConfig struct {
+	Host                        types.String `tfsdk:"host"`
+	Port                        types.Int32  `tfsdk:"port"`
+	Region                      types.String `tfsdk:"region"`
+	AwsAccessKeyID              types.String `tfsdk:"access_key_id"`
+	AwsSecretAccessKey          types.String `tfsdk:"secret_access_key"`
+	AwsSecretAccessKeyWO        types.String `tfsdk:"secret_access_key_wo"`
+	AwsSecretAccessKeyWOVersion types.Int64  `tfsdk:"secret_access_key_wo_version"`
+	RoleARN                     types.String `tfsdk:"role_arn"`
+	ExternalID                  types.String `tfsdk:"external_id"`
+}
+
+func (k KeyspacesSharedConfig) ToAPIModel() artieclient.ConnectorConfig {
+	return artieclient.ConnectorConfig{
+		"host":               k.Host.ValueString(),
+		"port":               k.Port.ValueInt32(),
+		"awsRegion":          k.Region.ValueString(),
+		"awsAccessKeyID":     k.AwsAccessKeyID.ValueString(),
+		"awsSecretAccessKey": secretValue(k.AwsSecretAccessKey, k.AwsSecretAccessKeyWO),
+		"awsRoleARN":         k.RoleARN.ValueString(),
+		"awsExternalID":      k.ExternalID.ValueString(),
+	}
+}
+
+func KeyspacesSharedConfigFromAPIModel(apiModel artieclient.ConnectorConfig) *KeyspacesSharedConfig {
+	return &KeyspacesSharedConfig{
+		Host:           types.StringValue(apiModel.String("host")),
+		Port:           types.Int32Value(apiModel.Int32("port")),
+		Region:         types.StringValue(apiModel.String("awsRegion")),
+		AwsAccessKeyID: types.StringValue(apiModel.String("awsAccessKeyID")),
+		RoleARN:        types.StringValue(apiModel.String("awsRoleARN")),
+		ExternalID:     types.StringValue(apiModel.String("awsExternalID")),
+	}
+}
+
+func (k KeyspacesSharedConfig) Validate(diagnostics *diag.Diagnostics) {
+	if tfmodels.IsKnownAndEmpty(k.RoleARN) {
+		if tfmodels.IsKnownAndEmpty(k.AwsAccessKeyID) {
+			diagnostics.AddError("access_key_id is required", "Please provide `access_key_id` inside `keyspaces_config`, or set `role_arn` to use IAM role assumption instead.")
+		}
+		if secretIsEmpty(k.AwsSecretAccessKey, k.AwsSecretAccessKeyWO) {
+			diagnostics.AddError("secret_access_key is required", "Please provide `secret_access_key` inside `keyspaces_config`, or set `role_arn` to use IAM role assumption instead.")
+		}
+	}
+}
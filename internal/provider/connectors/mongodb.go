@@ -0,0 +1,47 @@
+package connectors
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-artie/internal/artieclient"
+)
+
+const MongoDB artieclient.ConnectorType = "mongodb"
+
+func init() {
+	register(Definition{
+		Type:      MongoDB,
+		Direction: Source,
+		Attributes: map[string]schema.Attribute{
+			"host":     schema.StringAttribute{Required: true, MarkdownDescription: "The connection string for the MongoDB server. This can be either SRV or standard format."},
+			"username": schema.StringAttribute{Required: true, MarkdownDescription: "The username of the service account we will use to connect to the MongoDB database."},
+			"password": schema.StringAttribute{Required: true, Sensitive: true, MarkdownDescription: "The password of the service account we will use to connect to the MongoDB database. We recommend storing this in a secret manager and referencing it via a *sensitive* Terraform variable, instead of putting it in plaintext in your Terraform config file."},
+		},
+		NewConfig:          func() Config { return &MongoDBSharedConfig{} },
+		ConfigFromAPIModel: func(apiModel artieclient.ConnectorConfig) Config { return MongoDBSharedConfigFromAPIModel(apiModel) },
+	})
+}
+
+type MongoDBSharedConfig struct {
+	Host              types.String `tfsdk:"host"`
+	Username          types.String `tfsdk:"username"`
+	Password          types.String `tfsdk:"password"`
+	PasswordWO        types.String `tfsdk:"password_wo"`
+	PasswordWOVersion types.Int64  `tfsdk:"password_wo_version"`
+}
+
+func (m MongoDBSharedConfig) ToAPIModel() artieclient.ConnectorConfig {
+	return artieclient.ConnectorConfig{
+		"host":     m.Host.ValueString(),
+		"user":     m.Username.ValueString(),
+		"password": secretValue(m.Password, m.PasswordWO),
+	}
+}
+
+func MongoDBSharedConfigFromAPIModel(apiModel artieclient.ConnectorConfig) *MongoDBSharedConfig {
+	return &MongoDBSharedConfig{
+		Host:     types.StringValue(apiModel.String("host")),
+		Username: types.StringValue(apiModel.String("user")),
+	}
+}
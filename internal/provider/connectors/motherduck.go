@@ -0,0 +1,43 @@
+package connectors
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-artie/internal/artieclient"
+)
+
+const MotherDuck artieclient.ConnectorType = "motherduck"
+
+func init() {
+	register(Definition{
+		Type:      MotherDuck,
+		Direction: Destination,
+		Attributes: map[string]schema.Attribute{
+			"token":    schema.StringAttribute{Required: true, Sensitive: true, MarkdownDescription: "The MotherDuck access token we should use to connect. We recommend storing this in a secret manager and referencing it via a *sensitive* Terraform variable, instead of putting it in plaintext in your Terraform config file."},
+			"database": schema.StringAttribute{Required: true, MarkdownDescription: "The name of the MotherDuck database that we should connect to."},
+		},
+		NewConfig:          func() Config { return &MotherDuckSharedConfig{} },
+		ConfigFromAPIModel: func(apiModel artieclient.ConnectorConfig) Config { return MotherDuckSharedConfigFromAPIModel(apiModel) },
+	})
+}
+
+type MotherDuckSharedConfig struct {
+	Token          types.String `tfsdk:"token"`
+	TokenWO        types.String `tfsdk:"token_wo"`
+	TokenWOVersion types.Int64  `tfsdk:"token_wo_version"`
+	Database       types.String `tfsdk:"database"`
+}
+
+func (m MotherDuckSharedConfig) ToAPIModel() artieclient.ConnectorConfig {
+	config := artieclient.ConnectorConfig{}
+	setIfNotZero(config, "motherDuckToken", secretValue(m.Token, m.TokenWO))
+	setIfNotZero(config, "database", m.Database.ValueString())
+	return config
+}
+
+func MotherDuckSharedConfigFromAPIModel(apiModel artieclient.ConnectorConfig) *MotherDuckSharedConfig {
+	return &MotherDuckSharedConfig{
+		Database: types.StringValue(apiModel.String("database")),
+	}
+}
@@ -0,0 +1,67 @@
+package connectors
+
+import (
+	"math"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-artie/internal/artieclient"
+)
+
+const MSSQL artieclient.ConnectorType = "mssql"
+
+func init() {
+	register(Definition{
+		Type:      MSSQL,
+		Direction: Source | Destination,
+		Attributes: map[string]schema.Attribute{
+			"host":          schema.StringAttribute{Required: true, MarkdownDescription: "The hostname of the Microsoft SQL Server. This must point to the primary host, not a read replica."},
+			"snapshot_host": schema.StringAttribute{Optional: true, Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}, MarkdownDescription: "The hostname of the Microsoft SQL Server that we should use to snapshot the database. This can be a read replica and will only be used if this connector is being used as a source. If not provided, we will use the `host` value."},
+			"port": schema.Int32Attribute{
+				Required:            true,
+				MarkdownDescription: "The default port for Microsoft SQL Server is 1433.",
+				Validators: []validator.Int32{
+					int32validator.Between(1024, math.MaxUint16),
+				},
+			},
+			"username": schema.StringAttribute{Required: true, MarkdownDescription: "The username of the service account we will use to connect to the database."},
+			"password": schema.StringAttribute{Required: true, Sensitive: true, MarkdownDescription: "The password of the service account. We recommend storing this in a secret manager and referencing it via a *sensitive* Terraform variable, instead of putting it in plaintext in your Terraform config file."},
+		},
+		NewConfig:          func() Config { return &MSSQLSharedConfig{} },
+		ConfigFromAPIModel: func(apiModel artieclient.ConnectorConfig) Config { return MSSQLSharedConfigFromAPIModel(apiModel) },
+	})
+}
+
+type MSSQLSharedConfig struct {
+	Host              types.String `tfsdk:"host"`
+	SnapshotHost      types.String `tfsdk:"snapshot_host"`
+	Port              types.Int32  `tfsdk:"port"`
+	Username          types.String `tfsdk:"username"`
+	Password          types.String `tfsdk:"password"`
+	PasswordWO        types.String `tfsdk:"password_wo"`
+	PasswordWOVersion types.Int64  `tfsdk:"password_wo_version"`
+}
+
+func (r MSSQLSharedConfig) ToAPIModel() artieclient.ConnectorConfig {
+	return artieclient.ConnectorConfig{
+		"host":         r.Host.ValueString(),
+		"snapshotHost": r.SnapshotHost.ValueString(),
+		"port":         r.Port.ValueInt32(),
+		"username":     r.Username.ValueString(),
+		"password":     secretValue(r.Password, r.PasswordWO),
+	}
+}
+
+func MSSQLSharedConfigFromAPIModel(apiModel artieclient.ConnectorConfig) *MSSQLSharedConfig {
+	return &MSSQLSharedConfig{
+		Host:         types.StringValue(apiModel.String("host")),
+		SnapshotHost: types.StringValue(apiModel.String("snapshotHost")),
+		Port:         types.Int32Value(apiModel.Int32("port")),
+		Username:     types.StringValue(apiModel.String("username")),
+	}
+}
@@ -0,0 +1,86 @@
+package connectors
+
+import (
+	"math"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-artie/internal/artieclient"
+)
+
+const MySQL artieclient.ConnectorType = "mysql"
+
+func init() {
+	register(Definition{
+		Type:      MySQL,
+		Direction: Source,
+		Attributes: map[string]schema.Attribute{
+			"host":          schema.StringAttribute{Required: true, MarkdownDescription: "The hostname of the MySQL database. This must point to the primary host, not a read replica."},
+			"snapshot_host": schema.StringAttribute{Optional: true, Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}, MarkdownDescription: "The hostname of the MySQL database that we should use to snapshot the database. This can be a read replica and will only be used if this connector is being used as a source. If not provided, we will use the `host` value."},
+			"snapshot_port": schema.Int32Attribute{Optional: true, Computed: true, MarkdownDescription: "The port of the MySQL database that we should use to snapshot the database. If not provided, we will use the `port` value."},
+			"port": schema.Int32Attribute{
+				Required:            true,
+				MarkdownDescription: "The default port for MySQL is 3306.",
+				Validators: []validator.Int32{
+					int32validator.Between(1024, math.MaxUint16),
+				},
+			},
+			"username": schema.StringAttribute{Required: true, MarkdownDescription: "The username of the service account we will use to connect to the MySQL database. This service account needs enough permissions to read from the server binlogs."},
+			"password": schema.StringAttribute{Required: true, Sensitive: true, MarkdownDescription: "The password of the service account. We recommend storing this in a secret manager and referencing it via a *sensitive* Terraform variable, instead of putting it in plaintext in your Terraform config file."},
+			"tls_mode": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				MarkdownDescription: "The TLS mode for the MySQL connection. Use `\"\"` (empty string) to disable TLS, or `\"preferred\"` to enable TLS preferred mode.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("", "preferred"),
+				},
+			},
+		},
+		NewConfig:          func() Config { return &MySQLSharedConfig{} },
+		ConfigFromAPIModel: func(apiModel artieclient.ConnectorConfig) Config { return MySQLSharedConfigFromAPIModel(apiModel) },
+	})
+}
+
+type MySQLSharedConfig struct {
+	Host              types.String `tfsdk:"host"`
+	SnapshotHost      types.String `tfsdk:"snapshot_host"`
+	SnapshotPort      types.Int32  `tfsdk:"snapshot_port"`
+	Port              types.Int32  `tfsdk:"port"`
+	Username          types.String `tfsdk:"username"`
+	Password          types.String `tfsdk:"password"`
+	PasswordWO        types.String `tfsdk:"password_wo"`
+	PasswordWOVersion types.Int64  `tfsdk:"password_wo_version"`
+	TLSMode           types.String `tfsdk:"tls_mode"`
+}
+
+func (m MySQLSharedConfig) ToAPIModel() artieclient.ConnectorConfig {
+	config := artieclient.ConnectorConfig{
+		"host":         m.Host.ValueString(),
+		"snapshotHost": m.SnapshotHost.ValueString(),
+		"port":         m.Port.ValueInt32(),
+		"user":         m.Username.ValueString(),
+		"password":     secretValue(m.Password, m.PasswordWO),
+	}
+	setIfNotZero(config, "snapshotPort", m.SnapshotPort.ValueInt32())
+	setIfNotZero(config, "tlsMode", m.TLSMode.ValueString())
+	return config
+}
+
+func MySQLSharedConfigFromAPIModel(apiModel artieclient.ConnectorConfig) *MySQLSharedConfig {
+	return &MySQLSharedConfig{
+		Host:         types.StringValue(apiModel.String("host")),
+		SnapshotHost: types.StringValue(apiModel.String("snapshotHost")),
+		SnapshotPort: types.Int32Value(apiModel.Int32("snapshotPort")),
+		Port:         types.Int32Value(apiModel.Int32("port")),
+		Username:     types.StringValue(apiModel.String("user")),
+		TLSMode:      types.StringValue(apiModel.String("tlsMode")),
+	}
+}
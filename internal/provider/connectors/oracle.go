@@ -0,0 +1,66 @@
+package connectors
+
+import (
+	"math"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-artie/internal/artieclient"
+)
+
+const Oracle artieclient.ConnectorType = "oracle"
+
+func init() {
+	register(Definition{
+		Type:      Oracle,
+		Direction: Source,
+		Attributes: map[string]schema.Attribute{
+			"host":          schema.StringAttribute{Required: true, MarkdownDescription: "The hostname of the Oracle database. This must point to the primary host, not a read replica. This database must also have `ARCHIVELOG` mode and supplemental logging enabled."},
+			"snapshot_host": schema.StringAttribute{Optional: true, Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}, MarkdownDescription: "The hostname of the Oracle database that we should use to snapshot the database. This can be a read replica and will only be used if this connector is being used as a source. If not provided, we will use the `host` value."},
+			"port": schema.Int32Attribute{
+				Required:            true,
+				MarkdownDescription: "The default port for Oracle is 1521.",
+				Validators: []validator.Int32{
+					int32validator.Between(1024, math.MaxUint16),
+				},
+			},
+			"username": schema.StringAttribute{Required: true, MarkdownDescription: "The username of the service account we will use to connect to the Oracle database."},
+			"password": schema.StringAttribute{Required: true, Sensitive: true, MarkdownDescription: "The password of the service account. We recommend storing this in a secret manager and referencing it via a *sensitive* Terraform variable, instead of putting it in plaintext in your Terraform config file."},
+		},
+		NewConfig:          func() Config { return &OracleSharedConfig{} },
+		ConfigFromAPIModel: func(apiModel artieclient.ConnectorConfig) Config { return OracleSharedConfigFromAPIModel(apiModel) },
+	})
+}
+
+type OracleSharedConfig struct {
+	Host              types.String `tfsdk:"host"`
+	SnapshotHost      types.String `tfsdk:"snapshot_host"`
+	Port              types.Int32  `tfsdk:"port"`
+	Username          types.String `tfsdk:"username"`
+	Password          types.String `tfsdk:"password"`
+	PasswordWO        types.String `tfsdk:"password_wo"`
+	PasswordWOVersion types.Int64  `tfsdk:"password_wo_version"`
+}
+
+func (o OracleSharedConfig) ToAPIModel() artieclient.ConnectorConfig {
+	return artieclient.ConnectorConfig{
+		"host":         o.Host.ValueString(),
+		"snapshotHost": o.SnapshotHost.ValueString(),
+		"port":         o.Port.ValueInt32(),
+		"user":         o.Username.ValueString(),
+		"password":     secretValue(o.Password, o.PasswordWO),
+	}
+}
+
+func OracleSharedConfigFromAPIModel(apiModel artieclient.ConnectorConfig) *OracleSharedConfig {
+	return &OracleSharedConfig{
+		Host:     types.StringValue(apiModel.String("host")),
+		Port:     types.Int32Value(apiModel.Int32("port")),
+		Username: types.StringValue(apiModel.String("user")),
+	}
+}
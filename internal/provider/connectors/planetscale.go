@@ -0,0 +1,106 @@
+package connectors
+
+import (
+	"math"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-artie/internal/artieclient"
+)
+
+const PlanetScale artieclient.ConnectorType = "planetscale"
+
+func init() {
+	register(Definition{
+		Type:      PlanetScale,
+		Direction: Source,
+		Attributes: map[string]schema.Attribute{
+			"host": schema.StringAttribute{Required: true, MarkdownDescription: "The hostname of the PlanetScale database, e.g. `aws.connect.psdb.cloud`."},
+			"port": schema.Int32Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int32default.StaticInt32(3306),
+				MarkdownDescription: "The port of the PlanetScale database. This defaults to 3306.",
+				Validators: []validator.Int32{
+					int32validator.Between(1024, math.MaxUint16),
+				},
+			},
+			"username": schema.StringAttribute{Required: true, MarkdownDescription: "The username that we will use to connect to the PlanetScale database. PlanetScale usernames and passwords are scoped to a branch, so this must belong to `branch`."},
+			"password": schema.StringAttribute{Required: true, Sensitive: true, MarkdownDescription: "The PlanetScale password. We recommend storing this in a secret manager and referencing it via a *sensitive* Terraform variable, instead of putting it in plaintext in your Terraform config file."},
+			"branch": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("main"),
+				MarkdownDescription: "The PlanetScale branch that we should read from. This defaults to `main`.",
+			},
+			"shards": schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The Vitess shards that we should read from, e.g. `[\"-80\", \"80-\"]`. If not set, we will read from every shard in the keyspace. The keyspace itself is set with `database_name` on `artie_source_reader`.",
+				Validators:          []validator.List{listvalidator.SizeAtLeast(1)},
+			},
+		},
+		NewConfig: func() Config { return &PlanetScaleSharedConfig{} },
+		ConfigFromAPIModel: func(apiModel artieclient.ConnectorConfig) Config {
+			return PlanetScaleSharedConfigFromAPIModel(apiModel)
+		},
+	})
+}
+
+type PlanetScaleSharedConfig struct {
+	Host              types.String `tfsdk:"host"`
+	Port              types.Int32  `tfsdk:"port"`
+	Username          types.String `tfsdk:"username"`
+	Password          types.String `tfsdk:"password"`
+	PasswordWO        types.String `tfsdk:"password_wo"`
+	PasswordWOVersion types.Int64  `tfsdk:"password_wo_version"`
+	Branch            types.String `tfsdk:"branch"`
+	Shards            types.List   `tfsdk:"shards"`
+}
+
+func (p PlanetScaleSharedConfig) ToAPIModel() artieclient.ConnectorConfig {
+	var shards []string
+	for _, shard := range p.Shards.Elements() {
+		if shard, ok := shard.(types.String); ok {
+			shards = append(shards, shard.ValueString())
+		}
+	}
+
+	config := artieclient.ConnectorConfig{
+		"host":     p.Host.ValueString(),
+		"port":     p.Port.ValueInt32(),
+		"user":     p.Username.ValueString(),
+		"password": secretValue(p.Password, p.PasswordWO),
+	}
+	setIfNotZero(config, "branch", p.Branch.ValueString())
+	if len(shards) > 0 {
+		config["shards"] = shards
+	}
+	return config
+}
+
+func PlanetScaleSharedConfigFromAPIModel(apiModel artieclient.ConnectorConfig) *PlanetScaleSharedConfig {
+	shards := types.ListNull(types.StringType)
+	if apiShards := apiModel.Strings("shards"); len(apiShards) > 0 {
+		elements := make([]attr.Value, len(apiShards))
+		for i, shard := range apiShards {
+			elements[i] = types.StringValue(shard)
+		}
+		shards = types.ListValueMust(types.StringType, elements)
+	}
+
+	return &PlanetScaleSharedConfig{
+		Host:     types.StringValue(apiModel.String("host")),
+		Port:     types.Int32Value(apiModel.Int32("port")),
+		Username: types.StringValue(apiModel.String("user")),
+		Branch:   types.StringValue(apiModel.String("branch")),
+		Shards:   shards,
+	}
+}
@@ -0,0 +1,88 @@
+package connectors
+
+import (
+	"math"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-artie/internal/artieclient"
+)
+
+const Redis artieclient.ConnectorType = "redis"
+
+func init() {
+	register(Definition{
+		Type:      Redis,
+		Direction: Source,
+		Attributes: map[string]schema.Attribute{
+			"host": schema.StringAttribute{Required: true, MarkdownDescription: "The hostname of the Redis server. This must point to the primary, not a replica."},
+			"port": schema.Int32Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int32default.StaticInt32(6379),
+				MarkdownDescription: "The port of the Redis server. This defaults to 6379.",
+				Validators: []validator.Int32{
+					int32validator.Between(1024, math.MaxUint16),
+				},
+			},
+			"username": schema.StringAttribute{Optional: true, Computed: true, Default: stringdefault.StaticString(""), MarkdownDescription: "The ACL username we should use to connect to the Redis server. If not set, we will authenticate as the `default` user."},
+			"password": schema.StringAttribute{Optional: true, Computed: true, Sensitive: true, Default: stringdefault.StaticString(""), MarkdownDescription: "The password we should use to connect to the Redis server. We recommend storing this in a secret manager and referencing it via a *sensitive* Terraform variable, instead of putting it in plaintext in your Terraform config file."},
+			"tls_enabled": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether we should connect to the Redis server over TLS. This defaults to false.",
+			},
+			"database_index": schema.Int32Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int32default.StaticInt32(0),
+				MarkdownDescription: "The index of the Redis logical database that we should read from. This defaults to 0.",
+				Validators: []validator.Int32{
+					int32validator.AtLeast(0),
+				},
+			},
+		},
+		NewConfig:          func() Config { return &RedisSharedConfig{} },
+		ConfigFromAPIModel: func(apiModel artieclient.ConnectorConfig) Config { return RedisSharedConfigFromAPIModel(apiModel) },
+	})
+}
+
+type RedisSharedConfig struct {
+	Host              types.String `tfsdk:"host"`
+	Port              types.Int32  `tfsdk:"port"`
+	Username          types.String `tfsdk:"username"`
+	Password          types.String `tfsdk:"password"`
+	PasswordWO        types.String `tfsdk:"password_wo"`
+	PasswordWOVersion types.Int64  `tfsdk:"password_wo_version"`
+	TLSEnabled        types.Bool   `tfsdk:"tls_enabled"`
+	DatabaseIndex     types.Int32  `tfsdk:"database_index"`
+}
+
+func (r RedisSharedConfig) ToAPIModel() artieclient.ConnectorConfig {
+	config := artieclient.ConnectorConfig{
+		"host":       r.Host.ValueString(),
+		"port":       r.Port.ValueInt32(),
+		"username":   r.Username.ValueString(),
+		"password":   secretValue(r.Password, r.PasswordWO),
+		"tlsEnabled": r.TLSEnabled.ValueBool(),
+	}
+	setIfNotZero(config, "databaseIndex", r.DatabaseIndex.ValueInt32())
+	return config
+}
+
+func RedisSharedConfigFromAPIModel(apiModel artieclient.ConnectorConfig) *RedisSharedConfig {
+	return &RedisSharedConfig{
+		Host:          types.StringValue(apiModel.String("host")),
+		Port:          types.Int32Value(apiModel.Int32("port")),
+		Username:      types.StringValue(apiModel.String("username")),
+		TLSEnabled:    types.BoolValue(apiModel.Bool("tlsEnabled")),
+		DatabaseIndex: types.Int32Value(apiModel.Int32("databaseIndex")),
+	}
+}
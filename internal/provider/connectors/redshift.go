@@ -0,0 +1,47 @@
+package connectors
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-artie/internal/artieclient"
+)
+
+const Redshift artieclient.ConnectorType = "redshift"
+
+func init() {
+	register(Definition{
+		Type:      Redshift,
+		Direction: Destination,
+		Attributes: map[string]schema.Attribute{
+			"endpoint": schema.StringAttribute{Required: true, MarkdownDescription: "The endpoint URL of your Redshift cluster. This should include both the host and port."},
+			"username": schema.StringAttribute{Required: true, MarkdownDescription: "The username of the service account we should use to connect to Redshift."},
+			"password": schema.StringAttribute{Required: true, Sensitive: true, MarkdownDescription: "The password for the service account we should use to connect to Redshift. We recommend storing this in a secret manager and referencing it via a *sensitive* Terraform variable, instead of putting it in plaintext in your Terraform config file."},
+		},
+		NewConfig:          func() Config { return &RedshiftSharedConfig{} },
+		ConfigFromAPIModel: func(apiModel artieclient.ConnectorConfig) Config { return RedshiftSharedConfigFromAPIModel(apiModel) },
+	})
+}
+
+type RedshiftSharedConfig struct {
+	Endpoint          types.String `tfsdk:"endpoint"`
+	Username          types.String `tfsdk:"username"`
+	Password          types.String `tfsdk:"password"`
+	PasswordWO        types.String `tfsdk:"password_wo"`
+	PasswordWOVersion types.Int64  `tfsdk:"password_wo_version"`
+}
+
+func (r RedshiftSharedConfig) ToAPIModel() artieclient.ConnectorConfig {
+	return artieclient.ConnectorConfig{
+		"endpoint": r.Endpoint.ValueString(),
+		"username": r.Username.ValueString(),
+		"password": secretValue(r.Password, r.PasswordWO),
+	}
+}
+
+func RedshiftSharedConfigFromAPIModel(apiModel artieclient.ConnectorConfig) *RedshiftSharedConfig {
+	return &RedshiftSharedConfig{
+		Endpoint: types.StringValue(apiModel.String("endpoint")),
+		Username: types.StringValue(apiModel.String("username")),
+	}
+}
@@ -0,0 +1,218 @@
+// Package connectors defines the connector types that the artie_connector resource supports. Each type lives in its
+// own file, which registers its slug, direction, schema block, Terraform model, API converters and validation with
+// the registry in this file. Everything else (the resource schema, the list of source and destination types and the
+// docs) is derived from the registry, so adding a connector type only requires adding a file here.
+package connectors
+
+import (
+	"cmp"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-artie/internal/artieclient"
+	"terraform-provider-artie/internal/provider/tfmodels"
+)
+
+// Direction says whether a connector type can be read from, written to, or both.
+type Direction int
+
+const (
+	Source Direction = 1 << iota
+	Destination
+)
+
+const (
+	// WriteOnlySuffix is appended to the name of a secret attribute to get the name of its write-only variant.
+	WriteOnlySuffix = "_wo"
+	// WriteOnlyVersionSuffix is appended to the name of a secret attribute to get the name of the version attribute of
+	// its write-only variant.
+	WriteOnlyVersionSuffix = "_wo_version"
+)
+
+// Config is the Terraform model of a connector type's settings block.
+type Config interface {
+	ToAPIModel() artieclient.ConnectorConfig
+}
+
+// validatedConfig is implemented by configs that need checks that can't be expressed with schema validators.
+type validatedConfig interface {
+	Validate(diagnostics *diag.Diagnostics)
+}
+
+// Definition describes a connector type.
+type Definition struct {
+	Type      artieclient.ConnectorType
+	Direction Direction
+	// Description is appended to the description of the type's settings block.
+	Description string
+	// Attributes are the attributes of the type's settings block. Types without settings (e.g. `api`) leave this nil
+	// and don't get a block.
+	Attributes         map[string]schema.Attribute
+	NewConfig          func() Config
+	ConfigFromAPIModel func(apiModel artieclient.ConnectorConfig) Config
+}
+
+// HasConfig returns whether the connector type has a settings block.
+func (d Definition) HasConfig() bool {
+	return d.Attributes != nil
+}
+
+// AttributeName returns the name of the type's settings block, e.g. `postgresql_config`.
+func (d Definition) AttributeName() string {
+	return string(d.Type) + "_config"
+}
+
+// Secrets returns the names of the type's sensitive string attributes, sorted. Each of them gets a write-only
+// variant (see writeOnlyAttributes).
+func (d Definition) Secrets() []string {
+	var secrets []string
+	for name, attribute := range d.Attributes {
+		if _, ok := attribute.(schema.StringAttribute); ok && attribute.IsSensitive() {
+			secrets = append(secrets, name)
+		}
+	}
+	slices.Sort(secrets)
+	return secrets
+}
+
+// Attribute returns the type's settings block.
+func (d Definition) Attribute() schema.SingleNestedAttribute {
+	description := fmt.Sprintf("This should be filled out if the connector type is `%s`.", d.Type)
+	if d.Description != "" {
+		description += " " + d.Description
+	}
+
+	attributes := maps.Clone(d.Attributes)
+	for _, secret := range d.Secrets() {
+		addWriteOnlyAttributes(attributes, secret)
+	}
+
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: description,
+		Optional:            true,
+		Attributes:          attributes,
+	}
+}
+
+// addWriteOnlyAttributes adds `<secret>_wo` and `<secret>_wo_version` to attributes. The write-only attribute can be
+// used instead of secret so that the secret is never stored in state. Since Terraform can't tell when a write-only
+// value changes, the version attribute has to be changed to send a new value to Artie.
+func addWriteOnlyAttributes(attributes map[string]schema.Attribute, secret string) {
+	writeOnly := secret + WriteOnlySuffix
+	version := secret + WriteOnlyVersionSuffix
+
+	secretAttribute := attributes[secret].(schema.StringAttribute)
+	validators := []validator.String{stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName(secret))}
+	if secretAttribute.Required {
+		// One of the two is required now.
+		secretAttribute.Required = false
+		secretAttribute.Optional = true
+		secretAttribute.MarkdownDescription += fmt.Sprintf(" Either this or `%s` must be set.", writeOnly)
+		attributes[secret] = secretAttribute
+		validators = []validator.String{stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName(secret))}
+	}
+
+	attributes[writeOnly] = schema.StringAttribute{
+		Optional:            true,
+		Sensitive:           true,
+		WriteOnly:           true,
+		Validators:          validators,
+		MarkdownDescription: fmt.Sprintf("A write-only alternative to `%s` that is never stored in Terraform state. This requires Terraform 1.11 or later. Since Terraform can't detect changes to write-only values, you must also change `%s` whenever this changes.", secret, version),
+	}
+	attributes[version] = schema.Int64Attribute{
+		Optional:            true,
+		Validators:          []validator.Int64{int64validator.AlsoRequires(path.MatchRelative().AtParent().AtName(writeOnly))},
+		MarkdownDescription: fmt.Sprintf("The version of `%s`. Changing this sends the current value of `%s` to Artie, e.g. after rotating the secret.", writeOnly, writeOnly),
+	}
+}
+
+func (d Definition) attributeTypes() map[string]attr.Type {
+	return d.Attribute().GetType().(attr.TypeWithAttributeTypes).AttributeTypes()
+}
+
+var registry = map[artieclient.ConnectorType]Definition{}
+
+func register(definition Definition) {
+	if _, ok := registry[definition.Type]; ok {
+		panic(fmt.Sprintf("connector type %s is registered twice", definition.Type))
+	}
+	registry[definition.Type] = definition
+}
+
+// All returns every connector type, sorted by slug.
+func All() []Definition {
+	var definitions []Definition
+	for _, definition := range registry {
+		definitions = append(definitions, definition)
+	}
+	slices.SortFunc(definitions, func(a, b Definition) int {
+		return cmp.Compare(a.Type, b.Type)
+	})
+	return definitions
+}
+
+// Lookup returns the definition of the connector type with the given slug.
+func Lookup(connectorType string) (Definition, error) {
+	definition, ok := registry[artieclient.ConnectorType(connectorType)]
+	if !ok {
+		return Definition{}, fmt.Errorf("invalid connector type: %s", connectorType)
+	}
+	return definition, nil
+}
+
+func typesWithDirection(direction Direction) []string {
+	var connectorTypes []string
+	for _, definition := range All() {
+		if definition.Direction&direction != 0 {
+			connectorTypes = append(connectorTypes, string(definition.Type))
+		}
+	}
+	return connectorTypes
+}
+
+// Types returns the slugs of every connector type, sorted.
+func Types() []string {
+	return typesWithDirection(Source | Destination)
+}
+
+// SourceTypes returns the slugs of the connector types that can be used as a source, sorted.
+func SourceTypes() []string {
+	return typesWithDirection(Source)
+}
+
+// DestinationTypes returns the slugs of the connector types that can be used as a destination, sorted.
+func DestinationTypes() []string {
+	return typesWithDirection(Destination)
+}
+
+// secretValue returns the value of a secret, which is set either in its regular attribute or in its write-only variant.
+func secretValue(value types.String, writeOnly types.String) string {
+	if !writeOnly.IsNull() {
+		return writeOnly.ValueString()
+	}
+	return value.ValueString()
+}
+
+// secretIsEmpty returns whether neither a secret nor its write-only variant is set.
+func secretIsEmpty(value types.String, writeOnly types.String) bool {
+	return tfmodels.IsKnownAndEmpty(value) && tfmodels.IsKnownAndEmpty(writeOnly)
+}
+
+// setIfNotZero sets key in config unless value is its type's zero value, for settings that the API expects to be left
+// out when they aren't used.
+func setIfNotZero[T comparable](config artieclient.ConnectorConfig, key string, value T) {
+	var zero T
+	if value != zero {
+		config[key] = value
+	}
+}
@@ -0,0 +1,154 @@
+package connectors
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistry(t *testing.T) {
+	{
+		assert.IsIncreasing(t, Types())
+		assert.Contains(t, SourceTypes(), "postgresql")
+		assert.NotContains(t, SourceTypes(), "snowflake")
+		assert.Contains(t, DestinationTypes(), "snowflake")
+		assert.NotContains(t, DestinationTypes(), "postgresql")
+
+		// MSSQL can be used as both a source and a destination.
+		assert.Contains(t, SourceTypes(), "mssql")
+		assert.Contains(t, DestinationTypes(), "mssql")
+	}
+	{
+		definition, err := Lookup("postgresql")
+		require.NoError(t, err)
+		assert.Equal(t, PostgreSQL, definition.Type)
+		assert.Equal(t, "postgresql_config", definition.AttributeName())
+		assert.Equal(t, "This should be filled out if the connector type is `postgresql`.", definition.Attribute().MarkdownDescription)
+	}
+	{
+		definition, err := Lookup("api")
+		require.NoError(t, err)
+		assert.False(t, definition.HasConfig())
+	}
+	{
+		_, err := Lookup("oracle-but-not-really")
+		assert.ErrorContains(t, err, "invalid connector type: oracle-but-not-really")
+	}
+}
+
+func TestDefinition_WriteOnlyAttributes(t *testing.T) {
+	{
+		definition, err := Lookup("postgresql")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"password"}, definition.Secrets())
+
+		attributes := definition.Attribute().Attributes
+		// The password isn't required anymore, since it can be set with password_wo instead.
+		assert.True(t, attributes["password"].IsOptional())
+		assert.True(t, attributes["password_wo"].IsWriteOnly())
+		assert.True(t, attributes["password_wo"].IsSensitive())
+		assert.IsType(t, schema.Int64Attribute{}, attributes["password_wo_version"])
+		// The definition itself isn't changed.
+		assert.True(t, definition.Attributes["password"].IsRequired())
+		assert.NotContains(t, definition.Attributes, "password_wo")
+	}
+	{
+		definition, err := Lookup("databricks")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"client_secret", "personal_access_token"}, definition.Secrets())
+	}
+}
+
+// testState returns an empty state with the connector attributes of the artie_connector resource.
+func testState(t *testing.T) tfsdk.State {
+	attributes := map[string]schema.Attribute{}
+	for name := range (&Connector{}).commonAttributes() {
+		attributes[name] = schema.StringAttribute{Optional: true}
+	}
+	for name := range (&Connector{}).boolAttributes() {
+		attributes[name] = schema.BoolAttribute{Optional: true}
+	}
+	for _, definition := range All() {
+		if definition.HasConfig() {
+			attributes[definition.AttributeName()] = definition.Attribute()
+		}
+	}
+
+	s := schema.Schema{Attributes: attributes}
+	return tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(t.Context()), nil)}
+}
+
+func TestConnector_GetSet(t *testing.T) {
+	ctx := t.Context()
+	connector := Connector{
+		UUID:                    types.StringValue(uuid.NewString()),
+		SSHTunnelUUID:           types.StringValue(""),
+		PrivateLinkUUID:         types.StringValue(""),
+		SnapshotPrivateLinkUUID: types.StringValue(""),
+		Type:                    types.StringValue("mysql"),
+		Name:                    types.StringValue("Orders"),
+		DataPlaneName:           types.StringValue("aws-us-east-1"),
+		Config: &MySQLSharedConfig{
+			Host:         types.StringValue("db.example.com"),
+			SnapshotHost: types.StringValue("replica.example.com"),
+			SnapshotPort: types.Int32Value(3307),
+			Port:         types.Int32Value(3306),
+			Username:     types.StringValue("artie"),
+			Password:     types.StringValue("hunter2"),
+			TLSMode:      types.StringValue("required"),
+		},
+	}
+
+	state := testState(t)
+	diags := Set(ctx, &state, connector)
+	require.False(t, diags.HasError(), diags)
+
+	var host types.String
+	diags = state.GetAttribute(ctx, path.Root("mysql_config").AtName("host"), &host)
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, "db.example.com", host.ValueString())
+
+	// The blocks of the other connector types are null.
+	var postgresConfig types.Object
+	diags = state.GetAttribute(ctx, path.Root("postgresql_config"), &postgresConfig)
+	require.False(t, diags.HasError(), diags)
+	assert.True(t, postgresConfig.IsNull())
+
+	roundTripped, diags := Get(ctx, state)
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, connector, roundTripped)
+}
+
+func TestConnector_Validate(t *testing.T) {
+	{
+		connector := Connector{Type: types.StringValue("postgresql")}
+		diags := connector.Validate()
+		require.True(t, diags.HasError())
+		assert.Equal(t, "postgresql_config is required", diags.Errors()[0].Summary())
+		assert.Equal(t, "Please provide `postgresql_config` inside `connector`.", diags.Errors()[0].Detail())
+	}
+	{
+		// Types without settings and unknown types don't need a settings block.
+		assert.False(t, Connector{Type: types.StringValue("api")}.Validate().HasError())
+		assert.False(t, Connector{Type: types.StringUnknown()}.Validate().HasError())
+	}
+	{
+		connector := Connector{
+			Type: types.StringValue("clickhouse"),
+			Config: &ClickHouseSharedConfig{
+				TLSEnabled:    types.BoolValue(false),
+				TLSSkipVerify: types.BoolValue(true),
+			},
+		}
+		diags := connector.Validate()
+		require.True(t, diags.HasError())
+		assert.Equal(t, "tls_skip_verify requires TLS", diags.Errors()[0].Summary())
+	}
+}
@@ -0,0 +1,70 @@
+package connectors
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-artie/internal/artieclient"
+	"terraform-provider-artie/internal/provider/tfmodels"
+)
+
+const S3 artieclient.ConnectorType = "s3"
+
+func init() {
+	register(Definition{
+		Type:        S3,
+		Direction:   Destination,
+		Description: "You must provide either `role_arn` (for IAM role assumption) or both `access_key_id` and `secret_access_key` (for static credentials).",
+		Attributes: map[string]schema.Attribute{
+			"access_key_id":     schema.StringAttribute{Optional: true, Computed: true, Default: stringdefault.StaticString(""), MarkdownDescription: "The AWS Access Key ID for the service account we should use to connect to S3. Required if `role_arn` is not set."},
+			"secret_access_key": schema.StringAttribute{Optional: true, Computed: true, Sensitive: true, Default: stringdefault.StaticString(""), MarkdownDescription: "The AWS Secret Access Key for the service account we should use to connect to S3. Required if `role_arn` is not set. We recommend storing this in a secret manager and referencing it via a *sensitive* Terraform variable, instead of putting it in plaintext in your Terraform config file."},
+			"region":            schema.StringAttribute{Required: true, MarkdownDescription: "The AWS region where we should store your data in S3."},
+			"role_arn":          schema.StringAttribute{Optional: true, Computed: true, Default: stringdefault.StaticString(""), MarkdownDescription: "The ARN of the IAM role to assume for connecting to S3. If set, `access_key_id` and `secret_access_key` are not required."},
+			"external_id":       schema.StringAttribute{Optional: true, Computed: true, Default: stringdefault.StaticString(""), MarkdownDescription: "The external ID to use when assuming the IAM role. Only applicable when `role_arn` is set."},
+		},
+		NewConfig:          func() Config { return &S3SharedConfig{} },
+		ConfigFromAPIModel: func(apiModel artieclient.ConnectorConfig) Config { return S3SharedConfigFromAPIModel(apiModel) },
+	})
+}
+
+type S3SharedConfig struct {
+	AccessKeyID              types.String `tfsdk:"access_key_id"`
+	SecretAccessKey          types.String `tfsdk:"secret_access_key"`
+	SecretAccessKeyWO        types.String `tfsdk:"secret_access_key_wo"`
+	SecretAccessKeyWOVersion types.Int64  `tfsdk:"secret_access_key_wo_version"`
+	Region                   types.String `tfsdk:"region"`
+	RoleARN                  types.String `tfsdk:"role_arn"`
+	ExternalID               types.String `tfsdk:"external_id"`
+}
+
+func (s S3SharedConfig) ToAPIModel() artieclient.ConnectorConfig {
+	return artieclient.ConnectorConfig{
+		"awsAccessKeyID":     s.AccessKeyID.ValueString(),
+		"awsSecretAccessKey": secretValue(s.SecretAccessKey, s.SecretAccessKeyWO),
+		"awsRegion":          s.Region.ValueString(),
+		"awsRoleARN":         s.RoleARN.ValueString(),
+		"awsExternalID":      s.ExternalID.ValueString(),
+	}
+}
+
+func S3SharedConfigFromAPIModel(apiModel artieclient.ConnectorConfig) *S3SharedConfig {
+	return &S3SharedConfig{
+		AccessKeyID: types.StringValue(apiModel.String("awsAccessKeyID")),
+		Region:      types.StringValue(apiModel.String("awsRegion")),
+		RoleARN:     types.StringValue(apiModel.String("awsRoleARN")),
+		ExternalID:  types.StringValue(apiModel.String("awsExternalID")),
+	}
+}
+
+func (s S3SharedConfig) Validate(diagnostics *diag.Diagnostics) {
+	if tfmodels.IsKnownAndEmpty(s.RoleARN) {
+		if tfmodels.IsKnownAndEmpty(s.AccessKeyID) {
+			diagnostics.AddError("access_key_id is required", "Please provide `access_key_id` inside `s3_config`, or set `role_arn` to use IAM role assumption instead.")
+		}
+		if secretIsEmpty(s.SecretAccessKey, s.SecretAccessKeyWO) {
+			diagnostics.AddError("secret_access_key is required", "Please provide `secret_access_key` inside `s3_config`, or set `role_arn` to use IAM role assumption instead.")
+		}
+	}
+}
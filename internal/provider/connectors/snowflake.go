@@ -0,0 +1,76 @@
+package connectors
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-artie/internal/artieclient"
+)
+
+const Snowflake artieclient.ConnectorType = "snowflake"
+
+func init() {
+	register(Definition{
+		Type:      Snowflake,
+		Direction: Destination,
+		Attributes: map[string]schema.Attribute{
+			"account_identifier": schema.StringAttribute{Optional: true, Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}, MarkdownDescription: "The [account identifier](https://docs.snowflake.com/user-guide/admin-account-identifier) of your Snowflake account. We recommend using this instead of `account_url`."},
+			"account_url":        schema.StringAttribute{Optional: true, Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}, MarkdownDescription: "(Legacy) The [URL](https://docs.snowflake.com/user-guide/admin-account-identifier) of your Snowflake account. We recommend using `account_identifier` instead."},
+			"virtual_dwh":        schema.StringAttribute{Required: true, MarkdownDescription: "The name of your Snowflake virtual data warehouse."},
+			"username":           schema.StringAttribute{Required: true, MarkdownDescription: "The username of the service account we should use to connect to Snowflake."},
+			"password":           schema.StringAttribute{Optional: true, Computed: true, Sensitive: true, Default: stringdefault.StaticString(""), MarkdownDescription: "(Legacy) The password for the service account we should use to connect to Snowflake. We recommend using `private_key` instead."},
+			"private_key":        schema.StringAttribute{Optional: true, Computed: true, Sensitive: true, Default: stringdefault.StaticString(""), MarkdownDescription: "The private key for the service account we should use to connect to Snowflake. We recommend using this instead of `password`."},
+		},
+		NewConfig:          func() Config { return &SnowflakeSharedConfig{} },
+		ConfigFromAPIModel: func(apiModel artieclient.ConnectorConfig) Config { return SnowflakeSharedConfigFromAPIModel(apiModel) },
+	})
+}
+
+type SnowflakeSharedConfig struct {
+	AccountIdentifier   types.String `tfsdk:"account_identifier"`
+	AccountURL          types.String `tfsdk:"account_url"`
+	VirtualDWH          types.String `tfsdk:"virtual_dwh"`
+	Username            types.String `tfsdk:"username"`
+	Password            types.String `tfsdk:"password"`
+	PasswordWO          types.String `tfsdk:"password_wo"`
+	PasswordWOVersion   types.Int64  `tfsdk:"password_wo_version"`
+	PrivateKey          types.String `tfsdk:"private_key"`
+	PrivateKeyWO        types.String `tfsdk:"private_key_wo"`
+	PrivateKeyWOVersion types.Int64  `tfsdk:"private_key_wo_version"`
+}
+
+func (s SnowflakeSharedConfig) ToAPIModel() artieclient.ConnectorConfig {
+	return artieclient.ConnectorConfig{
+		"accountIdentifier": s.AccountIdentifier.ValueString(),
+		"accountURL":        s.AccountURL.ValueString(),
+		"virtualDWH":        s.VirtualDWH.ValueString(),
+		"privateKey":        secretValue(s.PrivateKey, s.PrivateKeyWO),
+		"username":          s.Username.ValueString(),
+		"password":          secretValue(s.Password, s.PasswordWO),
+	}
+}
+
+func SnowflakeSharedConfigFromAPIModel(apiModel artieclient.ConnectorConfig) *SnowflakeSharedConfig {
+	return &SnowflakeSharedConfig{
+		AccountIdentifier: types.StringValue(apiModel.String("accountIdentifier")),
+		AccountURL:        types.StringValue(apiModel.String("accountURL")),
+		VirtualDWH:        types.StringValue(apiModel.String("virtualDWH")),
+		Username:          types.StringValue(apiModel.String("username")),
+	}
+}
+
+func (s SnowflakeSharedConfig) Validate(diagnostics *diag.Diagnostics) {
+	// Either account_identifier or account_url must be provided
+	if s.AccountIdentifier.IsNull() && s.AccountURL.IsNull() {
+		diagnostics.AddError("Either account_identifier or account_url must be provided", "Please provide either `account_identifier` or `account_url` inside `snowflake_config`. We recommend using `account_identifier`.")
+	}
+
+	// Either password or private_key must be provided
+	if s.Password.IsNull() && s.PasswordWO.IsNull() && s.PrivateKey.IsNull() && s.PrivateKeyWO.IsNull() {
+		diagnostics.AddError("Either password or private_key must be provided", "Please provide either `password` or `private_key` inside `snowflake_config`. We recommend using `private_key`.")
+	}
+}
@@ -0,0 +1,140 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	apiKeyEnvVar   = "ARTIE_API_KEY"
+	endpointEnvVar = "ARTIE_ENDPOINT"
+
+	apiKeyPrefix         = "arsk_"
+	apiKeyCommandTimeout = 30 * time.Second
+)
+
+// apiKeySource describes where the API key was read from, so that diagnostics can point users at the right place.
+type apiKeySource struct {
+	description string
+	// attribute is the provider attribute the key came from, or empty if it came from the environment.
+	attribute string
+}
+
+func (s apiKeySource) addError(diags *diag.Diagnostics, summary, detail string) {
+	if s.attribute == "" {
+		diags.AddError(summary, detail)
+	} else {
+		diags.AddAttributeError(path.Root(s.attribute), summary, detail)
+	}
+}
+
+// resolveEndpoint returns the endpoint from the provider config, falling back to ARTIE_ENDPOINT and then the default.
+func resolveEndpoint(config ArtieProviderModel) string {
+	if !config.Endpoint.IsNull() {
+		return config.Endpoint.ValueString()
+	}
+	if endpoint := os.Getenv(endpointEnvVar); endpoint != "" {
+		return endpoint
+	}
+	return DEFAULT_API_ENDPOINT
+}
+
+// resolveAPIKey returns the API key from the first configured source, in order of precedence: the api_key attribute,
+// the api_key_file attribute, the api_key_command attribute and finally the ARTIE_API_KEY environment variable.
+func resolveAPIKey(ctx context.Context, config ArtieProviderModel) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var source apiKeySource
+	var apiKey string
+
+	switch {
+	case config.APIKey.IsUnknown() || config.APIKeyFile.IsUnknown() || config.APIKeyCommand.IsUnknown():
+		diags.AddError(
+			"Unknown Artie API key",
+			"The Artie API key depends on a value that won't be known until apply, so the provider can't be configured. Please use a value that's known at plan time, such as a variable.",
+		)
+		return "", diags
+	case !config.APIKey.IsNull():
+		source = apiKeySource{description: "the api_key attribute", attribute: "api_key"}
+		apiKey = config.APIKey.ValueString()
+	case !config.APIKeyFile.IsNull():
+		source = apiKeySource{description: fmt.Sprintf("the file %q (api_key_file)", config.APIKeyFile.ValueString()), attribute: "api_key_file"}
+		contents, err := os.ReadFile(config.APIKeyFile.ValueString())
+		if err != nil {
+			source.addError(&diags, "Unable to read Artie API key", fmt.Sprintf("Failed to read the API key from %s: %s", source.description, err))
+			return "", diags
+		}
+		apiKey = string(contents)
+	case !config.APIKeyCommand.IsNull():
+		var args []string
+		diags.Append(config.APIKeyCommand.ElementsAs(ctx, &args, false)...)
+		if diags.HasError() {
+			return "", diags
+		}
+		source = apiKeySource{description: fmt.Sprintf("the output of %q (api_key_command)", strings.Join(args, " ")), attribute: "api_key_command"}
+		output, err := runAPIKeyCommand(ctx, args)
+		if err != nil {
+			source.addError(&diags, "Unable to read Artie API key", fmt.Sprintf("Failed to read the API key from %s: %s", source.description, err))
+			return "", diags
+		}
+		apiKey = output
+	default:
+		apiKey = os.Getenv(apiKeyEnvVar)
+		if apiKey == "" {
+			diags.AddError(
+				"Missing Artie API key",
+				fmt.Sprintf("The provider needs an Artie API key. Please set one of the api_key, api_key_file or api_key_command attributes in the provider block, or the %s environment variable.", apiKeyEnvVar),
+			)
+			return "", diags
+		}
+		source = apiKeySource{description: fmt.Sprintf("the %s environment variable", apiKeyEnvVar)}
+	}
+
+	apiKey = strings.TrimSpace(apiKey)
+	if apiKey == "" {
+		source.addError(&diags, "Invalid Artie API key", fmt.Sprintf("The API key read from %s is empty.", source.description))
+		return "", diags
+	}
+	if !strings.HasPrefix(apiKey, apiKeyPrefix) {
+		source.addError(&diags, "Invalid Artie API key", fmt.Sprintf("The API key read from %s is malformed: Artie API keys start with %q.", source.description, apiKeyPrefix))
+		return "", diags
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Using Artie API key from %s", source.description))
+	return apiKey, diags
+}
+
+// runAPIKeyCommand runs a credential helper and returns its stdout. The command is run directly rather than through a
+// shell, so args[0] is the executable and the remaining elements are passed to it as-is.
+func runAPIKeyCommand(ctx context.Context, args []string) (string, error) {
+	if len(args) == 0 || args[0] == "" {
+		return "", fmt.Errorf("the command is empty")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, apiKeyCommandTimeout)
+	defer cancel()
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, args[0], args[1:]...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			return "", fmt.Errorf("the command did not finish within %s", apiKeyCommandTimeout)
+		}
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return "", fmt.Errorf("%w: %s", err, msg)
+		}
+		return "", err
+	}
+
+	return stdout.String(), nil
+}
@@ -0,0 +1,132 @@
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestProviderModel() ArtieProviderModel {
+	return ArtieProviderModel{
+		Endpoint:      types.StringNull(),
+		APIKey:        types.StringNull(),
+		APIKeyFile:    types.StringNull(),
+		APIKeyCommand: types.ListNull(types.StringType),
+		MaxRetries:    types.Int64Null(),
+		RetryMaxWait:  types.StringNull(),
+	}
+}
+
+func TestResolveAPIKey(t *testing.T) {
+	ctx := t.Context()
+	t.Setenv(apiKeyEnvVar, "")
+	{
+		// no source configured
+		_, diags := resolveAPIKey(ctx, newTestProviderModel())
+		require.True(t, diags.HasError())
+		assert.Equal(t, "Missing Artie API key", diags.Errors()[0].Summary())
+	}
+	{
+		// api_key attribute
+		config := newTestProviderModel()
+		config.APIKey = types.StringValue("arsk_attr")
+		apiKey, diags := resolveAPIKey(ctx, config)
+		assert.False(t, diags.HasError())
+		assert.Equal(t, "arsk_attr", apiKey)
+	}
+	{
+		// api_key attribute without the arsk_ prefix
+		config := newTestProviderModel()
+		config.APIKey = types.StringValue("not-a-key")
+		_, diags := resolveAPIKey(ctx, config)
+		require.True(t, diags.HasError())
+		assert.Contains(t, diags.Errors()[0].Detail(), "the api_key attribute is malformed")
+		assert.Equal(t, path.Root("api_key"), diags.Errors()[0].(diag.DiagnosticWithPath).Path())
+	}
+	{
+		// api_key_file attribute, with a trailing newline
+		keyPath := filepath.Join(t.TempDir(), "artie-api-key")
+		require.NoError(t, os.WriteFile(keyPath, []byte("arsk_file\n"), 0o600))
+		config := newTestProviderModel()
+		config.APIKeyFile = types.StringValue(keyPath)
+		apiKey, diags := resolveAPIKey(ctx, config)
+		assert.False(t, diags.HasError())
+		assert.Equal(t, "arsk_file", apiKey)
+	}
+	{
+		// api_key_file that doesn't exist
+		config := newTestProviderModel()
+		config.APIKeyFile = types.StringValue(filepath.Join(t.TempDir(), "missing"))
+		_, diags := resolveAPIKey(ctx, config)
+		require.True(t, diags.HasError())
+		assert.Equal(t, "Unable to read Artie API key", diags.Errors()[0].Summary())
+	}
+	{
+		// empty api_key_file
+		keyPath := filepath.Join(t.TempDir(), "artie-api-key")
+		require.NoError(t, os.WriteFile(keyPath, []byte("  \n"), 0o600))
+		config := newTestProviderModel()
+		config.APIKeyFile = types.StringValue(keyPath)
+		_, diags := resolveAPIKey(ctx, config)
+		require.True(t, diags.HasError())
+		assert.Contains(t, diags.Errors()[0].Detail(), "is empty")
+	}
+	{
+		// api_key_command attribute
+		config := newTestProviderModel()
+		config.APIKeyCommand = types.ListValueMust(types.StringType, []attr.Value{types.StringValue("echo"), types.StringValue("arsk_command")})
+		apiKey, diags := resolveAPIKey(ctx, config)
+		assert.False(t, diags.HasError())
+		assert.Equal(t, "arsk_command", apiKey)
+	}
+	{
+		// api_key_command that fails
+		config := newTestProviderModel()
+		config.APIKeyCommand = types.ListValueMust(types.StringType, []attr.Value{types.StringValue("sh"), types.StringValue("-c"), types.StringValue("echo locked >&2; exit 1")})
+		_, diags := resolveAPIKey(ctx, config)
+		require.True(t, diags.HasError())
+		assert.Contains(t, diags.Errors()[0].Detail(), "exit status 1: locked")
+	}
+	{
+		// environment variable
+		t.Setenv(apiKeyEnvVar, "arsk_env")
+		apiKey, diags := resolveAPIKey(ctx, newTestProviderModel())
+		assert.False(t, diags.HasError())
+		assert.Equal(t, "arsk_env", apiKey)
+	}
+	{
+		// the api_key attribute takes precedence over the environment variable
+		t.Setenv(apiKeyEnvVar, "arsk_env")
+		config := newTestProviderModel()
+		config.APIKey = types.StringValue("arsk_attr")
+		apiKey, diags := resolveAPIKey(ctx, config)
+		assert.False(t, diags.HasError())
+		assert.Equal(t, "arsk_attr", apiKey)
+	}
+	{
+		// malformed environment variable
+		t.Setenv(apiKeyEnvVar, "sk_env")
+		_, diags := resolveAPIKey(ctx, newTestProviderModel())
+		require.True(t, diags.HasError())
+		assert.Contains(t, diags.Errors()[0].Detail(), "the ARTIE_API_KEY environment variable is malformed")
+	}
+}
+
+func TestResolveEndpoint(t *testing.T) {
+	t.Setenv(endpointEnvVar, "")
+	assert.Equal(t, DEFAULT_API_ENDPOINT, resolveEndpoint(newTestProviderModel()))
+
+	t.Setenv(endpointEnvVar, "http://localhost:8000")
+	assert.Equal(t, "http://localhost:8000", resolveEndpoint(newTestProviderModel()))
+
+	config := newTestProviderModel()
+	config.Endpoint = types.StringValue("https://api.example.com")
+	assert.Equal(t, "https://api.example.com", resolveEndpoint(config))
+}
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"terraform-provider-artie/internal/artieclient"
@@ -14,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var _ resource.Resource = &EncryptionKeyResource{}
@@ -136,6 +138,11 @@ func (r *EncryptionKeyResource) Read(ctx context.Context, req resource.ReadReque
 
 	encryptionKey, err := r.client.EncryptionKeys().Get(ctx, stateData.UUID.ValueString())
 	if err != nil {
+		if errors.As(err, &artieclient.NotFoundError{}) {
+			tflog.Warn(ctx, fmt.Sprintf("Encryption Key %s no longer exists, removing it from state", stateData.UUID.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError("Unable to read Encryption Key", err.Error())
 		return
 	}
@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestEncryptionKeyResource_ReadNotFound(t *testing.T) {
+	testReadRemovesMissingResource(t, NewEncryptionKeyResource)
+}
+
+func testAccEncryptionKeyConfig(description string) string {
+	return fmt.Sprintf(`
+resource "artie_encryption_key" "test" {
+  name        = "Key"
+  description = %q
+}
+`, description)
+}
+
+func TestAccEncryptionKeyResource(t *testing.T) {
+	server := newTestAccServer(t)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(server),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + testAccEncryptionKeyConfig("For PII columns"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("artie_encryption_key.test", "name", "Key"),
+					resource.TestCheckResourceAttr("artie_encryption_key.test", "type", "passphrase"),
+					resource.TestCheckResourceAttrSet("artie_encryption_key.test", "key"),
+				),
+			},
+			{
+				Config: testAccProviderConfig(server) + testAccEncryptionKeyConfig("For PII and PHI columns"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("artie_encryption_key.test", "description", "For PII and PHI columns"),
+					// The key material is only returned on create, so it must be kept across updates.
+					resource.TestCheckResourceAttrSet("artie_encryption_key.test", "key"),
+				),
+			},
+			{
+				ResourceName:                         "artie_encryption_key.test",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "uuid",
+				ImportStateVerifyIgnore:              []string{"key"},
+				ImportStateIdFunc:                    testAccImportStateUUID("artie_encryption_key.test"),
+			},
+		},
+	})
+}
@@ -0,0 +1,259 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-artie/internal/artieclient"
+	"terraform-provider-artie/internal/provider/tfmodels"
+)
+
+var _ resource.Resource = &IngestionAPIKeyResource{}
+var _ resource.ResourceWithConfigure = &IngestionAPIKeyResource{}
+var _ resource.ResourceWithModifyPlan = &IngestionAPIKeyResource{}
+var _ resource.ResourceWithValidateConfig = &IngestionAPIKeyResource{}
+
+func NewIngestionAPIKeyResource() resource.Resource {
+	return &IngestionAPIKeyResource{}
+}
+
+type IngestionAPIKeyResource struct {
+	ingestionAPIKeys artieclient.IngestionAPIKeyClient
+}
+
+func (r *IngestionAPIKeyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ingestion_api_key"
+}
+
+func (r *IngestionAPIKeyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Artie Ingestion API Key resource. This represents an API key that can be used to send data to a source reader whose connector is of type `api`. " +
+			"The key is rotated by creating a new key before deleting the old one, so that there's always a valid key; this happens when `rotation_triggers` or `source_reader_uuid` change, or when `expires_at` is removed. " +
+			"The Artie API can't read ingestion API keys, so refreshing doesn't detect keys that were changed or deleted outside of Terraform, and existing keys can't be imported.",
+		Attributes: map[string]schema.Attribute{
+			"uuid": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "A human-readable name for the API key.",
+			},
+			"source_reader_uuid": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The source reader that the API key can send data to. Changing this rotates the key.",
+			},
+			"expires_at": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "When the API key expires, as an RFC 3339 timestamp (e.g. `2030-01-01T00:00:00Z`). If this is not set, the key never expires. Removing this rotates the key, since the expiry of an existing key can't be cleared.",
+			},
+			"rotation_triggers": schema.MapAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Arbitrary values that rotate the API key when they change. For example, you can rotate the key on a schedule by setting a value from the `time_rotating` resource of the `hashicorp/time` provider.",
+			},
+			"last4": schema.StringAttribute{
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				MarkdownDescription: "The last 4 characters of the API key, which can be used to identify it.",
+			},
+			"secret": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				MarkdownDescription: "The API key itself. This value is sensitive and will not be displayed in plan output.",
+			},
+		},
+	}
+}
+
+func (r *IngestionAPIKeyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(ArtieProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected ArtieProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData))
+		return
+	}
+
+	openAPIClient, err := providerData.NewOpenAPIClient()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to build Artie client", err.Error())
+		return
+	}
+
+	r.ingestionAPIKeys = artieclient.NewIngestionAPIKeyClient(openAPIClient)
+}
+
+func (r *IngestionAPIKeyResource) GetUUIDFromState(ctx context.Context, state tfsdk.State, diagnostics *diag.Diagnostics) (string, bool) {
+	var stateData tfmodels.IngestionAPIKey
+	diagnostics.Append(state.Get(ctx, &stateData)...)
+	return stateData.UUID.ValueString(), diagnostics.HasError()
+}
+
+func (r *IngestionAPIKeyResource) GetPlanData(ctx context.Context, plan tfsdk.Plan, diagnostics *diag.Diagnostics) (tfmodels.IngestionAPIKey, bool) {
+	var planData tfmodels.IngestionAPIKey
+	diagnostics.Append(plan.Get(ctx, &planData)...)
+	return planData, diagnostics.HasError()
+}
+
+// needsRotation returns whether the key in state has to be replaced by a new one to match the plan.
+func (r *IngestionAPIKeyResource) needsRotation(stateData, planData tfmodels.IngestionAPIKey) bool {
+	return !planData.RotationTriggers.Equal(stateData.RotationTriggers) ||
+		!planData.SourceReaderUUID.Equal(stateData.SourceReaderUUID) ||
+		(!stateData.ExpiresAt.IsNull() && planData.ExpiresAt.IsNull())
+}
+
+func (r *IngestionAPIKeyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var configData tfmodels.IngestionAPIKey
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if _, diags := configData.ParseExpiresAt(); diags.HasError() {
+		for _, d := range diags {
+			resp.Diagnostics.AddAttributeError(path.Root("expires_at"), d.Summary(), d.Detail())
+		}
+	}
+}
+
+func (r *IngestionAPIKeyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to rotate if the key is being created or destroyed.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var stateData tfmodels.IngestionAPIKey
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+	planData, hasError := r.GetPlanData(ctx, req.Plan, &resp.Diagnostics)
+	if hasError {
+		return
+	}
+
+	if r.needsRotation(stateData, planData) {
+		planData.UUID = types.StringUnknown()
+		planData.Last4 = types.StringUnknown()
+		planData.Secret = types.StringUnknown()
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, planData)...)
+	}
+}
+
+// create creates a new key matching planData and sets it in state.
+// create creates a key and returns its UUID, which is empty if it couldn't be created. Setting the key's expiry can
+// still fail after it's created.
+func (r *IngestionAPIKeyResource) create(ctx context.Context, planData tfmodels.IngestionAPIKey, state *tfsdk.State, diagnostics *diag.Diagnostics) string {
+	createReq, diags := planData.ToCreateRequest()
+	diagnostics.Append(diags...)
+	if diagnostics.HasError() {
+		return ""
+	}
+
+	created, err := r.ingestionAPIKeys.Create(ctx, createReq)
+	if err != nil {
+		diagnostics.AddError("Unable to create Ingestion API Key", err.Error())
+		return ""
+	}
+
+	planData.Secret = types.StringValue(created.Secret)
+	diagnostics.Append(state.Set(ctx, tfmodels.IngestionAPIKeyFromAPIModel(created.IngestionAPIKey, planData))...)
+
+	// Keys can't be created with an expiry, so it has to be set afterwards.
+	createdUUID := created.IngestionAPIKey.Uuid.String()
+	if !planData.ExpiresAt.IsNull() {
+		r.update(ctx, createdUUID, planData, state, diagnostics)
+	}
+	return createdUUID
+}
+
+func (r *IngestionAPIKeyResource) update(ctx context.Context, ingestionAPIKeyUUID string, planData tfmodels.IngestionAPIKey, state *tfsdk.State, diagnostics *diag.Diagnostics) {
+	updateReq, diags := planData.ToUpdateRequest()
+	diagnostics.Append(diags...)
+	if diagnostics.HasError() {
+		return
+	}
+
+	updated, err := r.ingestionAPIKeys.Update(ctx, ingestionAPIKeyUUID, updateReq)
+	if err != nil {
+		diagnostics.AddError("Unable to update Ingestion API Key", err.Error())
+		return
+	}
+
+	diagnostics.Append(state.Set(ctx, tfmodels.IngestionAPIKeyFromAPIModel(*updated, planData))...)
+}
+
+func (r *IngestionAPIKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	planData, hasError := r.GetPlanData(ctx, req.Plan, &resp.Diagnostics)
+	if hasError {
+		return
+	}
+
+	r.create(ctx, planData, &resp.State, &resp.Diagnostics)
+}
+
+// Read keeps the prior state. The API has no endpoint for reading an ingestion API key, and sending an update that
+// doesn't change anything would still write to the key on every refresh.
+func (r *IngestionAPIKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Ingestion API Keys can't be read from the Artie API, keeping the prior state")
+}
+
+func (r *IngestionAPIKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	planData, hasError := r.GetPlanData(ctx, req.Plan, &resp.Diagnostics)
+	if hasError {
+		return
+	}
+
+	var stateData tfmodels.IngestionAPIKey
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !r.needsRotation(stateData, planData) {
+		r.update(ctx, stateData.UUID.ValueString(), planData, &resp.State, &resp.Diagnostics)
+		return
+	}
+
+	// Create the new key before deleting the old one so that clients can switch over to it without any downtime.
+	createdUUID := r.create(ctx, planData, &resp.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		if createdUUID != "" {
+			resp.Diagnostics.AddError(
+				"Unable to finish rotating Ingestion API Key",
+				fmt.Sprintf("The new API key (%s) was created, but the old one (%s) wasn't deleted because the new key couldn't be set up. Delete the old key manually once it's no longer used.", createdUUID, stateData.UUID.ValueString()),
+			)
+		}
+		return
+	}
+
+	if err := r.ingestionAPIKeys.Delete(ctx, stateData.UUID.ValueString()); err != nil && !errors.As(err, &artieclient.NotFoundError{}) {
+		resp.Diagnostics.AddError(
+			"Unable to delete rotated Ingestion API Key",
+			fmt.Sprintf("The new API key was created, but the old one (%s) could not be deleted and should be deleted manually: %s", stateData.UUID.ValueString(), err.Error()),
+		)
+	}
+}
+
+func (r *IngestionAPIKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ingestionAPIKeyUUID, hasError := r.GetUUIDFromState(ctx, req.State, &resp.Diagnostics)
+	if hasError {
+		return
+	}
+
+	if err := r.ingestionAPIKeys.Delete(ctx, ingestionAPIKeyUUID); err != nil {
+		resp.Diagnostics.AddError("Unable to delete Ingestion API Key", err.Error())
+	}
+}
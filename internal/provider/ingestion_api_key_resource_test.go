@@ -0,0 +1,218 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/http/httputil"
+	"net/url"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	tfresource "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"terraform-provider-artie/internal/artieclient"
+	"terraform-provider-artie/internal/openapi"
+	"terraform-provider-artie/internal/provider/tfmodels"
+)
+
+func TestIngestionAPIKeyResource_Read(t *testing.T) {
+	// Keys can't be read from the API, so Read keeps the prior state without sending any requests.
+	ctx := t.Context()
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	t.Cleanup(server.Close)
+
+	r := NewIngestionAPIKeyResource()
+	configureTestResource(t, r.(resource.ResourceWithConfigure), server.URL)
+	state := newTestState(t, ctx, r, "0f7e2d9c-4b1a-4c7e-9d3f-5a6b7c8d9e0f")
+	resp := resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, &resp)
+	assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	assert.True(t, resp.State.Raw.Equal(state.Raw))
+	assert.Zero(t, requests)
+}
+
+func TestIngestionAPIKeyResource_NeedsRotation(t *testing.T) {
+	r := &IngestionAPIKeyResource{}
+	triggers := func(value string) types.Map {
+		return types.MapValueMust(types.StringType, map[string]attr.Value{"rotated_at": types.StringValue(value)})
+	}
+	state := tfmodels.IngestionAPIKey{
+		Name:             types.StringValue("key"),
+		SourceReaderUUID: types.StringValue("reader"),
+		ExpiresAt:        types.StringValue("2030-01-01T00:00:00Z"),
+		RotationTriggers: triggers("2026-01-01"),
+	}
+	{
+		plan := state
+		plan.Name = types.StringValue("renamed")
+		plan.ExpiresAt = types.StringValue("2031-01-01T00:00:00Z")
+		assert.False(t, r.needsRotation(state, plan))
+	}
+	{
+		plan := state
+		plan.RotationTriggers = triggers("2026-02-01")
+		assert.True(t, r.needsRotation(state, plan))
+	}
+	{
+		plan := state
+		plan.SourceReaderUUID = types.StringUnknown()
+		assert.True(t, r.needsRotation(state, plan))
+	}
+	{
+		// The expiry of an existing key can't be cleared.
+		plan := state
+		plan.ExpiresAt = types.StringNull()
+		assert.True(t, r.needsRotation(state, plan))
+	}
+}
+
+func TestIngestionAPIKeyResource_Rotate(t *testing.T) {
+	ctx := t.Context()
+	server := newTestAccServer(t)
+	sourceReaderUUID := createTestPipeline(t, server, "orders").SourceReaderUUID.String()
+	openAPIClient, err := newTestProviderData(server.URL).NewOpenAPIClient()
+	require.NoError(t, err)
+
+	r := NewIngestionAPIKeyResource()
+	configureTestResource(t, r.(resource.ResourceWithConfigure), server.URL)
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	newPlan := func(data tfmodels.IngestionAPIKey) tfsdk.Plan {
+		plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+		diags := plan.Set(ctx, data)
+		require.False(t, diags.HasError(), diags)
+		return plan
+	}
+
+	planData := tfmodels.IngestionAPIKey{
+		UUID:             types.StringUnknown(),
+		Name:             types.StringValue("key"),
+		SourceReaderUUID: types.StringValue(sourceReaderUUID),
+		ExpiresAt:        types.StringValue("2030-01-01T00:00:00+00:00"),
+		RotationTriggers: types.MapValueMust(types.StringType, map[string]attr.Value{"rotated_at": types.StringValue("1")}),
+		Last4:            types.StringUnknown(),
+		Secret:           types.StringUnknown(),
+	}
+	createResp := resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}}
+	r.Create(ctx, resource.CreateRequest{Plan: newPlan(planData)}, &createResp)
+	require.False(t, createResp.Diagnostics.HasError(), createResp.Diagnostics)
+
+	var created tfmodels.IngestionAPIKey
+	createResp.Diagnostics.Append(createResp.State.Get(ctx, &created)...)
+	require.False(t, createResp.Diagnostics.HasError(), createResp.Diagnostics)
+	assert.NotEmpty(t, created.Secret.ValueString())
+	assert.Equal(t, created.Secret.ValueString()[len(created.Secret.ValueString())-4:], created.Last4.ValueString())
+	// The expiry is kept as it was written, even though the API formats it differently.
+	assert.Equal(t, "2030-01-01T00:00:00+00:00", created.ExpiresAt.ValueString())
+	objectCount := server.ObjectCount()
+
+	planData = created
+	planData.RotationTriggers = types.MapValueMust(types.StringType, map[string]attr.Value{"rotated_at": types.StringValue("2")})
+	modifyResp := resource.ModifyPlanResponse{Plan: newPlan(planData)}
+	r.(resource.ResourceWithModifyPlan).ModifyPlan(ctx, resource.ModifyPlanRequest{State: createResp.State, Plan: newPlan(planData)}, &modifyResp)
+	require.False(t, modifyResp.Diagnostics.HasError(), modifyResp.Diagnostics)
+	var modified tfmodels.IngestionAPIKey
+	modifyResp.Diagnostics.Append(modifyResp.Plan.Get(ctx, &modified)...)
+	assert.True(t, modified.UUID.IsUnknown())
+	assert.True(t, modified.Secret.IsUnknown())
+
+	updateResp := resource.UpdateResponse{State: createResp.State}
+	r.Update(ctx, resource.UpdateRequest{State: createResp.State, Plan: modifyResp.Plan}, &updateResp)
+	require.False(t, updateResp.Diagnostics.HasError(), updateResp.Diagnostics)
+
+	var rotated tfmodels.IngestionAPIKey
+	updateResp.Diagnostics.Append(updateResp.State.Get(ctx, &rotated)...)
+	require.False(t, updateResp.Diagnostics.HasError(), updateResp.Diagnostics)
+	assert.NotEqual(t, created.UUID, rotated.UUID)
+	assert.NotEqual(t, created.Secret, rotated.Secret)
+	assert.Equal(t, created.ExpiresAt, rotated.ExpiresAt)
+	// The old key is deleted once the new one exists.
+	assert.Equal(t, objectCount, server.ObjectCount())
+	// Keys can only be fetched by sending an update, which is fine in a test.
+	ingestionAPIKeys := artieclient.NewIngestionAPIKeyClient(openAPIClient)
+	_, err = ingestionAPIKeys.Update(ctx, created.UUID.ValueString(), openapi.RouterUpdateIngestionAPIKeyRequest{})
+	assert.ErrorAs(t, err, &artieclient.NotFoundError{})
+	fetched, err := ingestionAPIKeys.Update(ctx, rotated.UUID.ValueString(), openapi.RouterUpdateIngestionAPIKeyRequest{})
+	require.NoError(t, err)
+	assert.NotNil(t, fetched.ExpiresAt)
+
+	// If the new key's expiry can't be set, the old key is kept and the error says which one it is.
+	serverURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	mux := http.NewServeMux()
+	mux.Handle("/", httputil.NewSingleHostReverseProxy(serverURL))
+	mux.HandleFunc("POST /ingestion-api-keys/{uuid}", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+	failingServer := httptest.NewServer(mux)
+	t.Cleanup(failingServer.Close)
+	failing := NewIngestionAPIKeyResource()
+	configureTestResource(t, failing.(resource.ResourceWithConfigure), failingServer.URL)
+
+	planData = rotated
+	planData.UUID = types.StringUnknown()
+	planData.Secret = types.StringUnknown()
+	planData.RotationTriggers = types.MapValueMust(types.StringType, map[string]attr.Value{"rotated_at": types.StringValue("3")})
+	failedResp := resource.UpdateResponse{State: updateResp.State}
+	failing.Update(ctx, resource.UpdateRequest{State: updateResp.State, Plan: newPlan(planData)}, &failedResp)
+	require.True(t, failedResp.Diagnostics.HasError())
+	assert.Contains(t, failedResp.Diagnostics.Errors()[len(failedResp.Diagnostics.Errors())-1].Detail(), rotated.UUID.ValueString())
+	_, err = ingestionAPIKeys.Update(ctx, rotated.UUID.ValueString(), openapi.RouterUpdateIngestionAPIKeyRequest{})
+	assert.NoError(t, err)
+}
+
+func testAccIngestionAPIKeyConfig(name string, rotatedAt string) string {
+	return testAccSourceReaderConfig("Reader") + fmt.Sprintf(`
+resource "artie_ingestion_api_key" "test" {
+  name               = %q
+  source_reader_uuid = artie_source_reader.test.uuid
+  expires_at         = "2030-01-01T00:00:00Z"
+  rotation_triggers = {
+    rotated_at = %q
+  }
+}
+`, name, rotatedAt)
+}
+
+func TestAccIngestionAPIKeyResource(t *testing.T) {
+	server := newTestAccServer(t)
+	tfresource.Test(t, tfresource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(server),
+		Steps: []tfresource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + testAccIngestionAPIKeyConfig("Key", "2026-01-01"),
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckResourceAttr("artie_ingestion_api_key.test", "name", "Key"),
+					tfresource.TestCheckResourceAttr("artie_ingestion_api_key.test", "expires_at", "2030-01-01T00:00:00Z"),
+					tfresource.TestCheckResourceAttrPair("artie_ingestion_api_key.test", "source_reader_uuid", "artie_source_reader.test", "uuid"),
+					tfresource.TestCheckResourceAttrSet("artie_ingestion_api_key.test", "secret"),
+					tfresource.TestCheckResourceAttrSet("artie_ingestion_api_key.test", "last4"),
+				),
+			},
+			{
+				Config: testAccProviderConfig(server) + testAccIngestionAPIKeyConfig("Renamed Key", "2026-01-01"),
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckResourceAttr("artie_ingestion_api_key.test", "name", "Renamed Key"),
+					// The secret is only returned on create, so it must be kept across updates.
+					tfresource.TestCheckResourceAttrSet("artie_ingestion_api_key.test", "secret"),
+				),
+			},
+			{
+				Config: testAccProviderConfig(server) + testAccIngestionAPIKeyConfig("Renamed Key", "2026-02-01"),
+				Check:  tfresource.TestCheckResourceAttrSet("artie_ingestion_api_key.test", "secret"),
+			},
+		},
+	})
+}
@@ -0,0 +1,154 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-artie/internal/artieclient"
+	"terraform-provider-artie/internal/openapi"
+)
+
+const (
+	// defaultDeploymentTimeout is how long to wait for a pipeline to deploy if the `timeouts` block doesn't say.
+	defaultDeploymentTimeout = 30 * time.Minute
+	// defaultDeploymentPollInterval is how often to check on a pipeline that is being deployed or changing status.
+	defaultDeploymentPollInterval = 5 * time.Second
+)
+
+// userSettablePipelineStatuses are the statuses that `desired_status` accepts. A pipeline is only a draft until it's
+// first started, so that can't be set.
+var userSettablePipelineStatuses = []string{
+	string(openapi.EnumsPipelineStatusRunning),
+	string(openapi.EnumsPipelineStatusPaused),
+	string(openapi.EnumsPipelineStatusTransferPaused),
+}
+
+// errDeploymentFailed is returned by waitForDeployment if a deployment finished without deploying the pipeline's
+// changes.
+var errDeploymentFailed = errors.New("the deployment finished, but the pipeline still has undeployed changes")
+
+// describePipelineDeployment summarizes the deployment state of a pipeline for diagnostics.
+func describePipelineDeployment(pipeline artieclient.PipelineDetails) string {
+	description := fmt.Sprintf("status %q, deploying: %t, undeployed changes: %t", pipeline.Status, pipeline.IsDeploying, pipeline.HasUndeployedChanges)
+	if pipeline.LastDeployedAt != nil {
+		description += fmt.Sprintf(", last deployed at %s", pipeline.LastDeployedAt.Format(time.RFC3339))
+	}
+	return description
+}
+
+// waitForDeployment polls a pipeline every pollInterval until it has been deployed, and returns its final state. The
+// API doesn't report why a deployment failed, so a deployment is treated as failed if it stops without deploying all
+// of the pipeline's changes. If ctx is done first, the last state that was seen is returned along with ctx's error.
+func waitForDeployment(ctx context.Context, pipelines artieclient.PipelineClient, pipelineUUID string, pollInterval time.Duration) (artieclient.PipelineDetails, error) {
+	sawDeploying := false
+	return pollPipeline(ctx, pipelines, pipelineUUID, pollInterval, "deploy", func(pipeline artieclient.PipelineDetails) (bool, error) {
+		switch {
+		case pipeline.IsDeploying:
+			sawDeploying = true
+		case !pipeline.HasUndeployedChanges:
+			return true, nil
+		case sawDeploying:
+			return true, errDeploymentFailed
+		}
+		return false, nil
+	})
+}
+
+// waitForStatus polls a pipeline every pollInterval until it has the given status, and returns its final state. If
+// ctx is done first, the last state that was seen is returned along with ctx's error.
+func waitForStatus(ctx context.Context, pipelines artieclient.PipelineClient, pipelineUUID string, status openapi.EnumsPipelineStatus, pollInterval time.Duration) (artieclient.PipelineDetails, error) {
+	return pollPipeline(ctx, pipelines, pipelineUUID, pollInterval, fmt.Sprintf("be %s", status), func(pipeline artieclient.PipelineDetails) (bool, error) {
+		return pipeline.Status == status, nil
+	})
+}
+
+// pollPipeline reads a pipeline every pollInterval until done reports that it's finished, and returns the last state
+// that was seen. goal describes what's being waited for in debug logs.
+func pollPipeline(ctx context.Context, pipelines artieclient.PipelineClient, pipelineUUID string, pollInterval time.Duration, goal string, done func(artieclient.PipelineDetails) (bool, error)) (artieclient.PipelineDetails, error) {
+	var pipeline artieclient.PipelineDetails
+	for {
+		latest, err := pipelines.Get(ctx, pipelineUUID)
+		if err != nil {
+			return pipeline, err
+		}
+		pipeline = latest
+
+		if finished, err := done(pipeline); finished {
+			return pipeline, err
+		}
+
+		tflog.Debug(ctx, fmt.Sprintf("Waiting for pipeline %s to %s (%s)", pipelineUUID, goal, describePipelineDeployment(pipeline)))
+		timer := time.NewTimer(pollInterval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return pipeline, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// waitForDeployment waits up to timeout for a pipeline to be deployed, adding an error to diagnostics if the
+// deployment fails or takes too long.
+func (r *PipelineResource) waitForDeployment(ctx context.Context, pipelineUUID string, timeout time.Duration, diagnostics *diag.Diagnostics) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	pipeline, err := waitForDeployment(ctx, r.client.Pipelines(r.openAPIClient), pipelineUUID, r.deploymentPollInterval)
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		diagnostics.AddError(
+			"Timed out waiting for Pipeline to deploy",
+			fmt.Sprintf("Pipeline %s wasn't deployed within %s (%s).", pipelineUUID, timeout, describePipelineDeployment(pipeline)),
+		)
+	case errors.Is(err, errDeploymentFailed):
+		diagnostics.AddError(
+			"Pipeline deployment failed",
+			fmt.Sprintf("Pipeline %s failed to deploy: %s (%s). Please check the pipeline in the Artie dashboard.", pipelineUUID, err, describePipelineDeployment(pipeline)),
+		)
+	case err != nil:
+		diagnostics.AddError("Unable to check Pipeline deployment", err.Error())
+	default:
+		tflog.Info(ctx, fmt.Sprintf("Pipeline %s has been deployed (%s)", pipelineUUID, describePipelineDeployment(pipeline)))
+	}
+}
+
+// setStatus changes a pipeline's status and waits up to timeout for the change to take effect, adding an error to
+// diagnostics if it doesn't. Nothing is changed if the pipeline already has the given status.
+func (r *PipelineResource) setStatus(ctx context.Context, pipelineUUID string, status openapi.EnumsPipelineStatus, timeout time.Duration, diagnostics *diag.Diagnostics) {
+	pipelines := r.client.Pipelines(r.openAPIClient)
+	pipeline, err := pipelines.Get(ctx, pipelineUUID)
+	if err != nil {
+		diagnostics.AddError("Unable to Read Pipeline", err.Error())
+		return
+	}
+	if pipeline.Status == status {
+		return
+	}
+
+	if err := pipelines.UpdateStatus(ctx, pipelineUUID, string(status)); err != nil {
+		diagnostics.AddError("Unable to update Pipeline status", fmt.Sprintf("Unable to change the status of pipeline %s from %q to %q: %s", pipelineUUID, pipeline.Status, status, err))
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	pipeline, err = waitForStatus(ctx, pipelines, pipelineUUID, status, r.deploymentPollInterval)
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		diagnostics.AddError(
+			"Timed out waiting for Pipeline status to change",
+			fmt.Sprintf("Pipeline %s didn't become %s within %s (%s).", pipelineUUID, status, timeout, describePipelineDeployment(pipeline)),
+		)
+	case err != nil:
+		diagnostics.AddError("Unable to check Pipeline status", err.Error())
+	default:
+		tflog.Info(ctx, fmt.Sprintf("Pipeline %s is now %s", pipelineUUID, status))
+	}
+}
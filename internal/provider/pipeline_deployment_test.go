@@ -0,0 +1,180 @@
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"terraform-provider-artie/internal/openapi"
+)
+
+func TestPipelineResource_WaitForDeployment(t *testing.T) {
+	ctx := t.Context()
+	server := newTestAccServer(t)
+	r := &PipelineResource{deploymentPollInterval: time.Millisecond}
+	configureTestResource(t, r, server.URL)
+	pipelines := r.client.Pipelines(r.openAPIClient)
+	{
+		pipeline := createTestPipeline(t, server, "deployed")
+		require.NoError(t, pipelines.StartPipeline(ctx, pipeline.UUID.String(), openapi.RouterPipelineStartRequest{}))
+
+		details, err := waitForDeployment(ctx, pipelines, pipeline.UUID.String(), r.deploymentPollInterval)
+		require.NoError(t, err)
+		assert.False(t, details.IsDeploying)
+		assert.False(t, details.HasUndeployedChanges)
+		assert.NotNil(t, details.LastDeployedAt)
+
+		var diags diag.Diagnostics
+		r.waitForDeployment(ctx, pipeline.UUID.String(), time.Minute, &diags)
+		assert.False(t, diags.HasError(), diags)
+	}
+	{
+		pipeline := createTestPipeline(t, server, "failed")
+		server.FailDeployments(pipeline.UUID)
+		require.NoError(t, pipelines.StartPipeline(ctx, pipeline.UUID.String(), openapi.RouterPipelineStartRequest{}))
+
+		var diags diag.Diagnostics
+		r.waitForDeployment(ctx, pipeline.UUID.String(), time.Minute, &diags)
+		require.True(t, diags.HasError())
+		assert.Equal(t, "Pipeline deployment failed", diags.Errors()[0].Summary())
+		assert.Contains(t, diags.Errors()[0].Detail(), `status "running"`)
+	}
+	{
+		// Pipelines that are never started are never deployed.
+		pipeline := createTestPipeline(t, server, "draft")
+
+		var diags diag.Diagnostics
+		r.waitForDeployment(ctx, pipeline.UUID.String(), 20*time.Millisecond, &diags)
+		require.True(t, diags.HasError())
+		assert.Equal(t, "Timed out waiting for Pipeline to deploy", diags.Errors()[0].Summary())
+		assert.Contains(t, diags.Errors()[0].Detail(), `status "draft"`)
+	}
+}
+
+func TestPipelineResource_SetStatus(t *testing.T) {
+	ctx := t.Context()
+	server := newTestAccServer(t)
+	r := &PipelineResource{deploymentPollInterval: time.Millisecond}
+	configureTestResource(t, r, server.URL)
+	pipelines := r.client.Pipelines(r.openAPIClient)
+	{
+		pipeline := createTestPipeline(t, server, "paused")
+		require.NoError(t, pipelines.StartPipeline(ctx, pipeline.UUID.String(), openapi.RouterPipelineStartRequest{}))
+
+		var diags diag.Diagnostics
+		r.setStatus(ctx, pipeline.UUID.String(), openapi.EnumsPipelineStatusPaused, time.Minute, &diags)
+		require.False(t, diags.HasError(), diags)
+		details, err := pipelines.Get(ctx, pipeline.UUID.String())
+		require.NoError(t, err)
+		assert.Equal(t, openapi.EnumsPipelineStatusPaused, details.Status)
+
+		// Setting the status it already has is a no-op.
+		r.setStatus(ctx, pipeline.UUID.String(), openapi.EnumsPipelineStatusPaused, time.Minute, &diags)
+		assert.False(t, diags.HasError(), diags)
+	}
+	{
+		// Pipelines that are never started stay drafts.
+		pipeline := createTestPipeline(t, server, "draft")
+		timeoutCtx, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+		defer cancel()
+
+		details, err := waitForStatus(timeoutCtx, pipelines, pipeline.UUID.String(), openapi.EnumsPipelineStatusRunning, time.Millisecond)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Equal(t, openapi.EnumsPipelineStatusDraft, details.Status)
+	}
+}
+
+// readTestPipelineState returns the state of a deployed pipeline with desired_status set.
+func readTestPipelineState(t *testing.T, r *PipelineResource, pipelineUUID string) tfsdk.State {
+	ctx := t.Context()
+	state := newTestState(t, ctx, r, pipelineUUID)
+	diags := state.SetAttribute(ctx, path.Root("desired_status"), "running")
+	require.False(t, diags.HasError(), diags)
+
+	resp := resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, &resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	return resp.State
+}
+
+// planFromState returns a plan that's the same as state apart from the given attributes.
+func planFromState(t *testing.T, state tfsdk.State, attributes map[string]any) tfsdk.Plan {
+	plan := tfsdk.Plan{Schema: state.Schema, Raw: state.Raw.Copy()}
+	for name, value := range attributes {
+		diags := plan.SetAttribute(t.Context(), path.Root(name), value)
+		require.False(t, diags.HasError(), diags)
+	}
+	return plan
+}
+
+func TestOnlyDesiredStatusChanged(t *testing.T) {
+	server := newTestAccServer(t)
+	r := &PipelineResource{deploymentPollInterval: time.Millisecond}
+	configureTestResource(t, r, server.URL)
+	pipeline := createTestPipeline(t, server, "status")
+	state := readTestPipelineState(t, r, pipeline.UUID.String())
+
+	for _, tc := range []struct {
+		name       string
+		attributes map[string]any
+		expected   bool
+	}{
+		{name: "unchanged", expected: true},
+		{name: "desired status", attributes: map[string]any{"desired_status": "paused"}, expected: true},
+		{
+			name:       "desired status and computed values",
+			attributes: map[string]any{"desired_status": "paused", "status": types.StringUnknown(), "last_updated_at": types.StringUnknown()},
+			expected:   true,
+		},
+		{name: "name", attributes: map[string]any{"desired_status": "paused", "name": "renamed"}, expected: false},
+		{name: "wait for deployment", attributes: map[string]any{"wait_for_deployment": true}, expected: false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			statusOnly, err := onlyDesiredStatusChanged(planFromState(t, state, tc.attributes), state)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, statusOnly)
+		})
+	}
+}
+
+func TestPipelineResource_UpdateDesiredStatusOnly(t *testing.T) {
+	ctx := t.Context()
+	server := newTestAccServer(t)
+	recorder := newRequestRecorder(t, server.URL)
+	r := &PipelineResource{deploymentPollInterval: time.Millisecond}
+	configureTestResource(t, r, recorder.URL)
+	pipelines := r.client.Pipelines(r.openAPIClient)
+
+	pipeline := createTestPipeline(t, server, "status only")
+	pipelineUUID := pipeline.UUID.String()
+	require.NoError(t, pipelines.StartPipeline(ctx, pipelineUUID, openapi.RouterPipelineStartRequest{}))
+	_, err := waitForDeployment(ctx, pipelines, pipelineUUID, time.Millisecond)
+	require.NoError(t, err)
+
+	state := readTestPipelineState(t, r, pipelineUUID)
+	plan := planFromState(t, state, map[string]any{"desired_status": "paused", "status": types.StringUnknown()})
+	requestCount := len(recorder.Requests())
+
+	resp := resource.UpdateResponse{State: state}
+	r.Update(ctx, resource.UpdateRequest{Plan: plan, State: state, Config: tfsdk.Config{Schema: plan.Schema, Raw: plan.Raw}}, &resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	// The status is changed without updating or redeploying the pipeline.
+	requests := recorder.Requests()[requestCount:]
+	assert.Contains(t, requests, "POST /pipelines/"+pipelineUUID+"/status")
+	assert.NotContains(t, requests, "POST /pipelines/"+pipelineUUID)
+	assert.NotContains(t, requests, "POST /pipelines/"+pipelineUUID+"/start")
+
+	var status string
+	diags := resp.State.GetAttribute(ctx, path.Root("status"), &status)
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, "paused", status)
+}
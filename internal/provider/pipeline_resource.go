@@ -2,12 +2,20 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
 
 	"terraform-provider-artie/internal/artieclient"
+	"terraform-provider-artie/internal/lib"
 	"terraform-provider-artie/internal/openapi"
+	"terraform-provider-artie/internal/provider/connectors"
 	"terraform-provider-artie/internal/provider/tfmodels"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -26,20 +34,25 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &PipelineResource{}
 var _ resource.ResourceWithConfigure = &PipelineResource{}
 var _ resource.ResourceWithImportState = &PipelineResource{}
+var _ resource.ResourceWithModifyPlan = &PipelineResource{}
 
 func NewPipelineResource() resource.Resource {
-	return &PipelineResource{}
+	return &PipelineResource{deploymentPollInterval: defaultDeploymentPollInterval}
 }
 
 type PipelineResource struct {
-	client        artieclient.Client
-	openAPIClient *openapi.ClientWithResponses
+	client                 artieclient.Client
+	openAPIClient          *openapi.ClientWithResponses
+	deploymentPollInterval time.Duration
+	validateOnPlan         bool
 }
 
 func (r *PipelineResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -89,6 +102,9 @@ func (r *PipelineResource) Schema(ctx context.Context, req resource.SchemaReques
 						"range_batch_size":       schema.Int64Attribute{Optional: true, Computed: true, PlanModifiers: []planmodifier.Int64{int64planmodifier.UseNonNullStateForUnknown()}, Validators: []validator.Int64{int64validator.AtLeast(0)}, MarkdownDescription: "The batch size Artie should use while processing each range backfill chunk. Set to 0 to use Artie's default. This is only applicable if `range_backfill` is set to true."},
 						"skip_backfill":          schema.BoolAttribute{Optional: true, Computed: true, PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseNonNullStateForUnknown()}, MarkdownDescription: "If set to true, Artie will skip backfilling this table and only process new changes going forward."},
 						"skip_no_op_updates":     schema.BoolAttribute{Optional: true, Computed: true, PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseNonNullStateForUnknown()}, MarkdownDescription: "If set to true, update events where the before and after rows are identical (after applying column inclusion/exclusion) will be skipped. Only supported for Postgres and requires REPLICA IDENTITY FULL."},
+						"redis_key_pattern":      schema.StringAttribute{Optional: true, Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseNonNullStateForUnknown()}, MarkdownDescription: "A glob-style pattern (e.g. `user:*`) that matches the Redis keys that should be replicated into this table. If not set, we will use `<name>:*`. This is only applicable if the source type is `redis`, in which case the table should not have a `schema`."},
+						"status":                 schema.StringAttribute{Computed: true, MarkdownDescription: "The status of the table, as reported by Artie."},
+						"history_table_status":   schema.StringAttribute{Computed: true, MarkdownDescription: "The status of the table's history table, as reported by Artie. This is only set if `enable_history_mode` is set to true."},
 						"merge_predicates": schema.ListNestedAttribute{
 							Optional:            true,
 							Computed:            true,
@@ -160,14 +176,14 @@ func (r *PipelineResource) Schema(ctx context.Context, req resource.SchemaReques
 				MarkdownDescription: "This contains configuration that pertains to the destination database but is specific to this pipeline. The basic connection settings for the destination, which can be shared by multiple pipelines, are stored in the corresponding `artie_connector` resource.",
 				Attributes: map[string]schema.Attribute{
 					"database": schema.StringAttribute{
-						MarkdownDescription: "The name of the database that data should be synced to in the destination. This should be filled if the destination is MS SQL or Snowflake, unless `use_same_schema_as_source` is set to true.",
+						MarkdownDescription: "The name of the database that data should be synced to in the destination. This should be filled if the destination is ClickHouse, MotherDuck, MS SQL or Snowflake, unless `use_same_schema_as_source` is set to true.",
 						Optional:            true,
 						Computed:            true,
 						Default:             stringdefault.StaticString(""),
 						PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 					},
 					"schema": schema.StringAttribute{
-						MarkdownDescription: "The name of the schema or namespace that data should be synced to in the destination. This should be filled if the destination is MS SQL, Redshift, Iceberg, or Snowflake (unless `use_same_schema_as_source` is set to true).",
+						MarkdownDescription: "The name of the schema or namespace that data should be synced to in the destination. This should be filled if the destination is MotherDuck, MS SQL, Redshift, Iceberg, or Snowflake (unless `use_same_schema_as_source` is set to true).",
 						Optional:            true,
 						Computed:            true,
 						Default:             stringdefault.StaticString(""),
@@ -194,21 +210,21 @@ func (r *PipelineResource) Schema(ctx context.Context, req resource.SchemaReques
 						PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 					},
 					"bucket": schema.StringAttribute{
-						MarkdownDescription: "The name of the S3 or GCS bucket that data should be synced to. This should be filled if the destination is S3, GCS, or Iceberg with provider `s3tables` (for Iceberg S3 Tables, this bucket is where delta files will be stored). Not used for Iceberg REST catalog.",
+						MarkdownDescription: "The name of the S3 or GCS bucket that data should be synced to. This should be filled if the destination is Delta Lake, S3, GCS, or Iceberg with provider `s3tables` (for Iceberg S3 Tables, this bucket is where delta files will be stored). Not used for Iceberg REST catalog.",
 						Optional:            true,
 						Computed:            true,
 						Default:             stringdefault.StaticString(""),
 						PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 					},
 					"table_name_separator": schema.StringAttribute{
-						MarkdownDescription: "If provided, this is the separator between database, schema and table name. This is only applicable if the destination is S3 or GCS.",
+						MarkdownDescription: "If provided, this is the separator between database, schema and table name. This is only applicable if the destination is Delta Lake, S3 or GCS. For Delta Lake, this cannot contain `/`.",
 						Optional:            true,
 						Computed:            true,
 						Default:             stringdefault.StaticString(""),
 						PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 					},
 					"folder": schema.StringAttribute{
-						MarkdownDescription: "If provided, all files will be stored under this folder inside the S3 or GCS bucket. This is optional and only applies if the destination is S3 or GCS.",
+						MarkdownDescription: "If provided, all files will be stored under this folder inside the S3 or GCS bucket. This is optional and only applies if the destination is Delta Lake, S3 or GCS.",
 						Optional:            true,
 						Computed:            true,
 						Default:             stringdefault.StaticString(""),
@@ -256,6 +272,40 @@ func (r *PipelineResource) Schema(ctx context.Context, req resource.SchemaReques
 				Optional:            true,
 				MarkdownDescription: "Override the pipeline status after update. Currently only `paused` is supported. If set to `paused`, the pipeline will be paused instead of started after an update. This cannot be set on creation.",
 				Validators:          []validator.String{stringvalidator.OneOf("paused")},
+				DeprecationMessage:  "Use `desired_status` instead. `status_override` will be removed in a future version.",
+			},
+			"desired_status": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The status the pipeline should have: `running`, `paused` or `transfer paused`. Terraform changes the pipeline's status to match after creating or updating it, waiting up to the `timeouts` for the change to take effect. Changing only `desired_status` changes the pipeline's status without redeploying it. Terraform also detects if the status is changed outside of Terraform. If this isn't set, the pipeline is started after every change and its status isn't managed.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(userSettablePipelineStatuses...),
+					stringvalidator.ConflictsWith(path.MatchRoot("status_override")),
+				},
+			},
+			"source_type":            schema.StringAttribute{Computed: true, MarkdownDescription: "The type of the pipeline's source connector, e.g. `postgresql`."},
+			"status":                 schema.StringAttribute{Computed: true, MarkdownDescription: "The status of the pipeline, e.g. `running` or `paused`."},
+			"is_deploying":           schema.BoolAttribute{Computed: true, MarkdownDescription: "Whether Artie is currently deploying the pipeline."},
+			"has_undeployed_changes": schema.BoolAttribute{Computed: true, MarkdownDescription: "Whether the pipeline has changes that haven't been deployed yet."},
+			"has_backfilling_tables": schema.BoolAttribute{Computed: true, MarkdownDescription: "Whether any of the pipeline's tables are being backfilled."},
+			"last_deployed_at":       schema.StringAttribute{Computed: true, MarkdownDescription: "When the pipeline was last deployed, in RFC 3339 format. This is null if the pipeline has never been deployed."},
+			"last_updated_at":        schema.StringAttribute{Computed: true, MarkdownDescription: "When the pipeline was last updated, in RFC 3339 format."},
+			"start_behavior": schema.SingleNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Controls how the pipeline is started after it's created or updated. This is only used by Terraform and isn't stored by Artie.",
+				Attributes: map[string]schema.Attribute{
+					"skip_backfill": schema.BoolAttribute{
+						Optional:            true,
+						MarkdownDescription: "If set to true, Artie won't backfill the pipeline's tables when it starts, and will only replicate new changes. This can't be combined with table settings that only affect backfills.",
+					},
+					"keep_paused": schema.BoolAttribute{
+						Optional:            true,
+						MarkdownDescription: "If set to true, the pipeline is deployed but left paused, e.g. until a maintenance window is over. To keep the pipeline paused until you change it, use `desired_status` instead.",
+					},
+				},
+			},
+			"wait_for_deployment": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "If set to true, creating or updating the pipeline waits until Artie has finished deploying it, and fails if the deployment fails. Use the `timeouts` block to change how long to wait, which defaults to 30 minutes.",
 			},
 			"static_columns": schema.ListNestedAttribute{
 				Optional:            true,
@@ -276,6 +326,9 @@ func (r *PipelineResource) Schema(ctx context.Context, req resource.SchemaReques
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Update: true}),
+		},
 	}
 }
 
@@ -305,6 +358,7 @@ func (r *PipelineResource) Configure(ctx context.Context, req resource.Configure
 
 	r.client = client
 	r.openAPIClient = openAPIClient
+	r.validateOnPlan = providerData.ValidatePipelinesOnPlan
 }
 
 func (r *PipelineResource) GetUUIDFromState(ctx context.Context, state tfsdk.State, diagnostics *diag.Diagnostics) (string, bool) {
@@ -319,17 +373,38 @@ func (r *PipelineResource) GetPlanData(ctx context.Context, plan tfsdk.Plan, dia
 	return planData, diagnostics.HasError()
 }
 
-func (r *PipelineResource) SetStateData(ctx context.Context, state *tfsdk.State, diagnostics *diag.Diagnostics, apiModel artieclient.Pipeline, statusOverride types.String) {
+// SetStateData saves apiModel into state. Settings that only affect what Terraform does aren't stored by Artie, so
+// they're copied from prior (the plan or the prior state).
+func (r *PipelineResource) SetStateData(ctx context.Context, state *tfsdk.State, diagnostics *diag.Diagnostics, apiModel artieclient.PipelineDetails, prior tfmodels.Pipeline) {
 	pipeline, diags := tfmodels.PipelineFromAPIModel(ctx, apiModel)
 	diagnostics.Append(diags...)
 	if diagnostics.HasError() {
 		return
 	}
 
-	pipeline.StatusOverride = statusOverride
+	pipeline.StatusOverride = prior.StatusOverride
+	pipeline.DesiredStatus = prior.DesiredStatus
+	pipeline.StartBehavior = prior.StartBehavior
+	pipeline.WaitForDeployment = prior.WaitForDeployment
+	pipeline.Timeouts = prior.Timeouts
 	diagnostics.Append(state.Set(ctx, pipeline)...)
 }
 
+// refreshStateData reads a pipeline and saves it into state. Starting, pausing or deploying a pipeline changes its
+// runtime state, so this is used after doing any of those. The state is refreshed even if diagnostics already has
+// errors, so that it shows why a deployment failed.
+func (r *PipelineResource) refreshStateData(ctx context.Context, state *tfsdk.State, diagnostics *diag.Diagnostics, pipelineUUID string, prior tfmodels.Pipeline) {
+	pipeline, err := r.client.Pipelines(r.openAPIClient).Get(ctx, pipelineUUID)
+	if err != nil {
+		diagnostics.AddError("Unable to Read Pipeline", err.Error())
+		return
+	}
+
+	var diags diag.Diagnostics
+	r.SetStateData(ctx, state, &diags, pipeline, prior)
+	diagnostics.Append(diags...)
+}
+
 func (r *PipelineResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
 	var configData tfmodels.Pipeline
 	resp.Diagnostics.Append(req.Config.Get(ctx, &configData)...)
@@ -348,8 +423,8 @@ func (r *PipelineResource) ValidateConfig(ctx context.Context, req resource.Vali
 		)
 	}
 
+	tables := map[string]tfmodels.Table{}
 	if tfmodels.IsKnown(configData.Tables) {
-		tables := map[string]tfmodels.Table{}
 		resp.Diagnostics.Append(configData.Tables.ElementsAs(ctx, &tables, false)...)
 
 		var hasColumnsToEncrypt bool
@@ -383,6 +458,12 @@ func (r *PipelineResource) ValidateConfig(ctx context.Context, req resource.Vali
 					resp.Diagnostics.AddError("Range batch size is required", "range_batch_size is required when range_backfill is enabled.")
 				}
 			}
+			// Only Redis tables have a key pattern, so we can check them here without looking up the source's type. Redis
+			// tables without one are checked by ModifyPlan (unless validate_pipelines_on_plan is false) and on apply,
+			// since ValidateConfig can't call the API.
+			if tfmodels.IsKnownAndNonEmpty(table.RedisKeyPattern) {
+				resp.Diagnostics.Append(validateRedisTable(tableKey, table)...)
+			}
 			if tfmodels.IsKnown(table.ColumnsToEncrypt) && len(table.ColumnsToEncrypt.Elements()) > 0 {
 				hasColumnsToEncrypt = true
 			}
@@ -399,6 +480,230 @@ func (r *PipelineResource) ValidateConfig(ctx context.Context, req resource.Vali
 			)
 		}
 	}
+
+	resp.Diagnostics.Append(validateStartBehavior(configData, tables)...)
+}
+
+// validateStartBehavior checks that start_behavior doesn't conflict with the rest of a pipeline's config. tables
+// should be empty if they aren't known yet.
+func validateStartBehavior(pipeline tfmodels.Pipeline, tables map[string]tfmodels.Table) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if pipeline.StartBehavior == nil {
+		return diags
+	}
+
+	if pipeline.StatusOverride.ValueString() == "paused" {
+		diags.AddAttributeError(path.Root("start_behavior"), "Invalid configuration", "`start_behavior` can't be set together with `status_override` because paused pipelines aren't started after an update. To deploy changes without starting the pipeline, set `start_behavior.keep_paused` to true instead.")
+	}
+
+	if pipeline.DesiredStatus.ValueString() == string(openapi.EnumsPipelineStatusRunning) && tfmodels.IsExplicitlyTrue(pipeline.StartBehavior.KeepPaused) {
+		diags.AddAttributeError(path.Root("start_behavior").AtName("keep_paused"), "Invalid configuration", "`start_behavior.keep_paused` can't be true when `desired_status` is \"running\". Set `desired_status` to \"paused\" instead.")
+	}
+
+	if !tfmodels.IsExplicitlyTrue(pipeline.StartBehavior.SkipBackfill) {
+		return diags
+	}
+
+	for _, tableKey := range slices.Sorted(maps.Keys(tables)) {
+		table := tables[tableKey]
+		backfillOptions := map[string]types.Bool{
+			"backfill_history_table": table.BackfillHistoryTable,
+			"ctid_backfill":          table.CTIDBackfill,
+			"range_backfill":         table.RangeBackfill,
+		}
+		for _, name := range slices.Sorted(maps.Keys(backfillOptions)) {
+			if tfmodels.IsExplicitlyTrue(backfillOptions[name]) {
+				diags.AddAttributeError(path.Root("tables").AtMapKey(tableKey).AtName(name), "Invalid configuration", fmt.Sprintf("%q table should not have `%s` set because `start_behavior.skip_backfill` is true.", tableKey, name))
+			}
+		}
+	}
+
+	return diags
+}
+
+// validateRedisTable checks that a table of a pipeline with a Redis source doesn't set options that only apply to
+// relational sources.
+func validateRedisTable(tableKey string, table tfmodels.Table) diag.Diagnostics {
+	var diags diag.Diagnostics
+	tablePath := path.Root("tables").AtMapKey(tableKey)
+
+	if tfmodels.IsKnownAndNonEmpty(table.Schema) {
+		diags.AddAttributeError(tablePath.AtName("schema"), "Invalid configuration", fmt.Sprintf("%q table should not have `schema` set because Redis doesn't have schemas.", tableKey))
+	}
+
+	unsupportedOptions := map[string]types.Bool{
+		"ctid_backfill":          table.CTIDBackfill,
+		"skip_no_op_updates":     table.SkipNoOpUpdates,
+		"unify_across_databases": table.UnifyAcrossDatabases,
+		"unify_across_schemas":   table.UnifyAcrossSchemas,
+	}
+	for _, name := range slices.Sorted(maps.Keys(unsupportedOptions)) {
+		if tfmodels.IsExplicitlyTrue(unsupportedOptions[name]) {
+			diags.AddAttributeError(tablePath.AtName(name), "Invalid configuration", fmt.Sprintf("%q table should not have `%s` set because it isn't supported for Redis sources.", tableKey, name))
+		}
+	}
+
+	return diags
+}
+
+// validateTablesForSource checks that tables only set options that apply to a source connector of sourceType.
+func validateTablesForSource(sourceType artieclient.ConnectorType, tables map[string]tfmodels.Table) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for _, tableKey := range slices.Sorted(maps.Keys(tables)) {
+		table := tables[tableKey]
+		if sourceType == connectors.Redis {
+			diags.Append(validateRedisTable(tableKey, table)...)
+		} else if tfmodels.IsKnownAndNonEmpty(table.RedisKeyPattern) {
+			diags.AddAttributeError(path.Root("tables").AtMapKey(tableKey).AtName("redis_key_pattern"), "Invalid configuration", fmt.Sprintf("%q table should not have `redis_key_pattern` set because it is only applicable if the source type is Redis.", tableKey))
+		}
+	}
+	return diags
+}
+
+// validateTables looks up the type of the pipeline's source connector and checks that the tables in config are valid
+// for it. This uses the config rather than the plan so that values filled in by the API aren't rejected.
+func (r *PipelineResource) validateTables(ctx context.Context, config tfsdk.Config) diag.Diagnostics {
+	var configData tfmodels.Pipeline
+	diags := config.Get(ctx, &configData)
+	if diags.HasError() || !tfmodels.IsKnown(configData.Tables) {
+		return diags
+	}
+
+	tables := map[string]tfmodels.Table{}
+	diags.Append(configData.Tables.ElementsAs(ctx, &tables, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	sourceReader, err := artieclient.NewSourceReaderClient(r.openAPIClient).Get(ctx, configData.SourceReaderUUID.ValueString())
+	if err != nil {
+		diags.AddError("Unable to Read Source Reader", err.Error())
+		return diags
+	}
+	source, err := r.client.Connectors().Get(ctx, sourceReader.ConnectorUUID.String())
+	if err != nil {
+		diags.AddError("Unable to Read source Connector", err.Error())
+		return diags
+	}
+	return validateTablesForSource(source.Type, tables)
+}
+
+// validateDestinationConfig checks that destinationConfig has the settings that a destination of destinationType needs.
+// Most destinations are only validated by the API when the pipeline is created or updated.
+func validateDestinationConfig(destinationType artieclient.ConnectorType, destinationConfig *tfmodels.PipelineDestinationConfig) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if destinationConfig == nil {
+		destinationConfig = &tfmodels.PipelineDestinationConfig{}
+	}
+
+	switch destinationType {
+	case connectors.MotherDuck:
+		if tfmodels.IsKnownAndEmpty(destinationConfig.Database) {
+			diags.AddAttributeError(path.Root("destination_config").AtName("database"), "database is required for MotherDuck", "Please provide `database` inside `destination_config` when the destination is MotherDuck.")
+		}
+		if tfmodels.IsKnownAndEmpty(destinationConfig.Schema) && !tfmodels.IsExplicitlyTrue(destinationConfig.UseSameSchemaAsSource) {
+			diags.AddAttributeError(path.Root("destination_config").AtName("schema"), "schema is required for MotherDuck", "Please provide `schema` inside `destination_config`, or set `use_same_schema_as_source` to true, when the destination is MotherDuck.")
+		}
+	case connectors.ClickHouse:
+		if tfmodels.IsKnownAndEmpty(destinationConfig.Database) && !tfmodels.IsExplicitlyTrue(destinationConfig.UseSameSchemaAsSource) {
+			diags.AddAttributeError(path.Root("destination_config").AtName("database"), "database is required for ClickHouse", "Please provide `database` inside `destination_config`, or set `use_same_schema_as_source` to true, when the destination is ClickHouse.")
+		}
+	case connectors.Delta:
+		if tfmodels.IsKnownAndEmpty(destinationConfig.Bucket) {
+			diags.AddAttributeError(path.Root("destination_config").AtName("bucket"), "bucket is required for Delta Lake", "Please provide `bucket` inside `destination_config` when the destination is Delta Lake.")
+		}
+		folder := destinationConfig.Folder.ValueString()
+		if strings.HasPrefix(folder, "/") || strings.HasSuffix(folder, "/") {
+			diags.AddAttributeError(path.Root("destination_config").AtName("folder"), "Invalid folder for Delta Lake", "`folder` inside `destination_config` should not start or end with `/`.")
+		}
+		// Each Delta table lives in its own directory, so a separator that adds path segments would nest tables.
+		if strings.Contains(destinationConfig.TableNameSeparator.ValueString(), "/") {
+			diags.AddAttributeError(path.Root("destination_config").AtName("table_name_separator"), "Invalid table_name_separator for Delta Lake", "`table_name_separator` inside `destination_config` cannot contain `/` when the destination is Delta Lake.")
+		}
+	}
+
+	return diags
+}
+
+// validateDestination looks up the pipeline's destination connector and checks that the pipeline's destination_config
+// is valid for it.
+func (r *PipelineResource) validateDestination(ctx context.Context, planData tfmodels.Pipeline) diag.Diagnostics {
+	var diags diag.Diagnostics
+	destination, err := r.client.Connectors().Get(ctx, planData.DestinationUUID.ValueString())
+	if err != nil {
+		diags.AddError("Unable to Read destination Connector", err.Error())
+		return diags
+	}
+	return validateDestinationConfig(destination.Type, planData.DestinationConfig)
+}
+
+func (r *PipelineResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to validate if the pipeline is being destroyed or hasn't changed, and nothing is looked up in the API
+	// during plan if validation is turned off.
+	if req.Plan.Raw.IsNull() || req.Plan.Raw.Equal(req.State.Raw) || !r.validateOnPlan {
+		return
+	}
+
+	var configData tfmodels.Pipeline
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Some table and destination settings are only valid for certain connector types, which ValidateConfig can't look
+	// up, so they're checked here whenever the source reader and destination are known.
+	if tfmodels.IsKnown(configData.SourceReaderUUID) {
+		resp.Diagnostics.Append(r.validateTables(ctx, req.Config)...)
+	}
+	if tfmodels.IsKnown(configData.DestinationUUID) {
+		resp.Diagnostics.Append(r.validateDestination(ctx, configData)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The pipeline is validated again when it's applied, so it's fine to skip this if the source reader, destination or
+	// tables depend on resources that haven't been created yet.
+	if !req.Config.Raw.IsFullyKnown() {
+		tflog.Info(ctx, "Skipping pipeline validation since some of the pipeline's settings are only known after apply")
+		return
+	}
+
+	tables := map[string]tfmodels.Table{}
+	resp.Diagnostics.Append(configData.Tables.ElementsAs(ctx, &tables, false)...)
+	pipeline, diags := configData.ToAPIBaseModel(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.Pipelines(r.openAPIClient).ValidateSource(ctx, pipeline); err != nil {
+		resp.Diagnostics.AddAttributeError(validationErrorPath(err, tables, path.Root("source_reader_uuid")), "Pipeline source validation failed", err.Error())
+		return
+	}
+
+	destinationPath := path.Root("destination_connector_uuid")
+	if configData.DestinationConfig != nil {
+		destinationPath = path.Root("destination_config")
+	}
+	if err := r.client.Pipelines(r.openAPIClient).ValidateDestination(ctx, pipeline); err != nil {
+		resp.Diagnostics.AddAttributeError(validationErrorPath(err, tables, destinationPath), "Pipeline destination validation failed", err.Error())
+	}
+}
+
+// validationErrorPath returns the path of the table that a validation error from the API is about, or fallback if it
+// isn't about exactly one table. The API only returns a message, so this looks for a table's quoted key or name in it.
+func validationErrorPath(err error, tables map[string]tfmodels.Table, fallback path.Path) path.Path {
+	var matches []string
+	for _, tableKey := range slices.Sorted(maps.Keys(tables)) {
+		if strings.Contains(err.Error(), fmt.Sprintf("%q", tableKey)) || strings.Contains(err.Error(), fmt.Sprintf("%q", tables[tableKey].Name.ValueString())) {
+			matches = append(matches, tableKey)
+		}
+	}
+	if len(matches) != 1 {
+		return fallback
+	}
+	return path.Root("tables").AtMapKey(matches[0])
 }
 
 func (r *PipelineResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -408,7 +713,7 @@ func (r *PipelineResource) Create(ctx context.Context, req resource.CreateReques
 	}
 
 	if planData.StatusOverride.ValueString() != "" {
-		resp.Diagnostics.AddError("Invalid configuration", "You cannot create a pipeline in a paused state. Please remove the status_override from your config, you can update the status of a running pipeline.")
+		resp.Diagnostics.AddError("Invalid configuration", "You cannot use status_override when creating a pipeline. To create a paused pipeline, set `desired_status` to \"paused\" instead.")
 		return
 	}
 
@@ -418,6 +723,12 @@ func (r *PipelineResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	resp.Diagnostics.Append(r.validateTables(ctx, req.Config)...)
+	resp.Diagnostics.Append(r.validateDestination(ctx, planData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if err := r.client.Pipelines(r.openAPIClient).ValidateSource(ctx, pipeline); err != nil {
 		resp.Diagnostics.AddError("Unable to create Pipeline", err.Error())
 		return
@@ -434,10 +745,14 @@ func (r *PipelineResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
-	r.SetStateData(ctx, &resp.State, &resp.Diagnostics, createdPipeline, planData.StatusOverride)
-	if err := r.client.Pipelines(r.openAPIClient).StartPipeline(ctx, createdPipeline.UUID.String()); err != nil {
-		resp.Diagnostics.AddError("Unable to start Pipeline", err.Error())
+	r.SetStateData(ctx, &resp.State, &resp.Diagnostics, createdPipeline, planData)
+	timeout, diags := planData.Timeouts.Create(ctx, defaultDeploymentTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
+	r.startPipeline(ctx, createdPipeline.UUID.String(), planData, timeout, &resp.Diagnostics)
+	r.refreshStateData(ctx, &resp.State, &resp.Diagnostics, createdPipeline.UUID.String(), planData)
 }
 
 func (r *PipelineResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -449,11 +764,21 @@ func (r *PipelineResource) Read(ctx context.Context, req resource.ReadRequest, r
 
 	pipeline, err := r.client.Pipelines(r.openAPIClient).Get(ctx, stateData.UUID.ValueString())
 	if err != nil {
+		if errors.As(err, &artieclient.NotFoundError{}) {
+			tflog.Warn(ctx, fmt.Sprintf("Pipeline %s no longer exists, removing it from state", stateData.UUID.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError("Unable to Read Pipeline", err.Error())
 		return
 	}
 
-	r.SetStateData(ctx, &resp.State, &resp.Diagnostics, pipeline, stateData.StatusOverride)
+	// desired_status is only managed if it's set, in which case it's refreshed so that status changes made outside of
+	// Terraform show up as drift.
+	if !stateData.DesiredStatus.IsNull() {
+		stateData.DesiredStatus = types.StringValue(string(pipeline.Status))
+	}
+	r.SetStateData(ctx, &resp.State, &resp.Diagnostics, pipeline, stateData)
 }
 
 func (r *PipelineResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -462,12 +787,41 @@ func (r *PipelineResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
+	if !planData.DesiredStatus.IsNull() {
+		statusOnly, err := onlyDesiredStatusChanged(req.Plan, req.State)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to compare Pipeline plan to state", err.Error())
+			return
+		}
+		if statusOnly {
+			// Nothing about the pipeline itself changed, so there's no need to update and redeploy it.
+			timeout, diags := planData.Timeouts.Update(ctx, defaultDeploymentTimeout)
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			pipelineUUID := planData.UUID.ValueString()
+			r.setStatus(ctx, pipelineUUID, openapi.EnumsPipelineStatus(planData.DesiredStatus.ValueString()), timeout, &resp.Diagnostics)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			r.refreshStateData(ctx, &resp.State, &resp.Diagnostics, pipelineUUID, planData)
+			return
+		}
+	}
+
 	apiBaseModel, diags := planData.ToAPIBaseModel(ctx)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.validateTables(ctx, req.Config)...)
+	resp.Diagnostics.Append(r.validateDestination(ctx, planData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if err := r.client.Pipelines(r.openAPIClient).ValidateSource(ctx, apiBaseModel); err != nil {
 		resp.Diagnostics.AddError("Unable to update Pipeline", err.Error())
 		return
@@ -490,17 +844,73 @@ func (r *PipelineResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
-	r.SetStateData(ctx, &resp.State, &resp.Diagnostics, updatedPipeline, planData.StatusOverride)
+	r.SetStateData(ctx, &resp.State, &resp.Diagnostics, updatedPipeline, planData)
 
 	if planData.StatusOverride.ValueString() == "paused" {
 		if err := r.client.Pipelines(r.openAPIClient).UpdateStatus(ctx, updatedPipeline.UUID.String(), "paused"); err != nil {
 			resp.Diagnostics.AddError("Unable to pause Pipeline", err.Error())
+			return
 		}
 	} else {
-		if err := r.client.Pipelines(r.openAPIClient).StartPipeline(ctx, updatedPipeline.UUID.String()); err != nil {
-			resp.Diagnostics.AddError("Unable to start Pipeline", err.Error())
+		timeout, diags := planData.Timeouts.Update(ctx, defaultDeploymentTimeout)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		r.startPipeline(ctx, updatedPipeline.UUID.String(), planData, timeout, &resp.Diagnostics)
+	}
+	r.refreshStateData(ctx, &resp.State, &resp.Diagnostics, updatedPipeline.UUID.String(), planData)
+}
+
+// onlyDesiredStatusChanged reports whether the only difference between a planned pipeline and its prior state is
+// desired_status. Values that are unknown in the plan are computed by Artie, so they're ignored.
+func onlyDesiredStatusChanged(plan tfsdk.Plan, state tfsdk.State) (bool, error) {
+	desiredStatusPath := tftypes.NewAttributePath().WithAttributeName("desired_status")
+	planned, err := tftypes.Transform(plan.Raw, func(attrPath *tftypes.AttributePath, value tftypes.Value) (tftypes.Value, error) {
+		if value.IsKnown() && !attrPath.Equal(desiredStatusPath) {
+			return value, nil
+		}
+		prior, _, err := tftypes.WalkAttributePath(state.Raw, attrPath)
+		if err != nil {
+			// The attribute doesn't exist in the prior state, so leave it for the comparison to catch.
+			return value, nil
+		}
+		priorValue, ok := prior.(tftypes.Value)
+		if !ok {
+			return value, nil
+		}
+		return priorValue, nil
+	})
+	if err != nil {
+		return false, err
+	}
+	return planned.Equal(state.Raw), nil
+}
+
+// startPipeline deploys a pipeline that has been created or updated and then gives it its desired status, waiting up
+// to timeout in total for the steps that the config asks to wait for. Pipelines that shouldn't end up running are
+// deployed without being started.
+func (r *PipelineResource) startPipeline(ctx context.Context, pipelineUUID string, planData tfmodels.Pipeline, timeout time.Duration, diagnostics *diag.Diagnostics) {
+	deadline := time.Now().Add(timeout)
+	startOptions := planData.StartBehavior.ToAPIModel()
+	desiredStatus := openapi.EnumsPipelineStatus(planData.DesiredStatus.ValueString())
+	if desiredStatus != "" && desiredStatus != openapi.EnumsPipelineStatusRunning {
+		startOptions.KeepPaused = lib.ToPtr(true)
+	}
+	if err := r.client.Pipelines(r.openAPIClient).StartPipeline(ctx, pipelineUUID, startOptions); err != nil {
+		diagnostics.AddError("Unable to start Pipeline", err.Error())
+		return
+	}
+
+	if planData.WaitForDeployment.ValueBool() {
+		r.waitForDeployment(ctx, pipelineUUID, timeout, diagnostics)
+		if diagnostics.HasError() {
+			return
 		}
 	}
+	if desiredStatus != "" {
+		r.setStatus(ctx, pipelineUUID, desiredStatus, time.Until(deadline).Round(time.Second), diagnostics)
+	}
 }
 
 func (r *PipelineResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -0,0 +1,581 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	tfresource "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"terraform-provider-artie/internal/artieclient"
+	"terraform-provider-artie/internal/artiefake"
+	"terraform-provider-artie/internal/lib"
+	"terraform-provider-artie/internal/openapi"
+	"terraform-provider-artie/internal/provider/connectors"
+	"terraform-provider-artie/internal/provider/tfmodels"
+)
+
+func TestPipelineResource_ReadNotFound(t *testing.T) {
+	testReadRemovesMissingResource(t, NewPipelineResource)
+}
+
+// testAccPipelineConfig returns the config of a pipeline with the given tables, and any other pipeline settings.
+func testAccPipelineConfig(tables string, settings string) string {
+	return fmt.Sprintf(`
+resource "artie_connector" "postgres" {
+  name = "Postgres"
+  type = "postgresql"
+  postgresql_config = {
+    host     = "db.example.com"
+    port     = 5432
+    username = "artie"
+    password = "hunter2"
+  }
+}
+
+resource "artie_connector" "snowflake" {
+  name = "Snowflake"
+  type = "snowflake"
+  snowflake_config = {
+    account_url = "https://abc12345.snowflakecomputing.com"
+    virtual_dwh = "compute_wh"
+    username    = "artie"
+    password    = "hunter2"
+  }
+}
+
+resource "artie_source_reader" "postgres" {
+  name           = "Reader"
+  connector_uuid = artie_connector.postgres.uuid
+  database_name  = "customers"
+}
+
+resource "artie_pipeline" "test" {
+  name                       = "Postgres to Snowflake"
+  source_reader_uuid         = artie_source_reader.postgres.uuid
+  destination_connector_uuid = artie_connector.snowflake.uuid
+  destination_config = {
+    database = "ANALYTICS"
+    schema   = "PUBLIC"
+  }
+  tables = {
+%s
+  }
+%s
+}
+`, tables, settings)
+}
+
+func TestAccPipelineResource(t *testing.T) {
+	server := newTestAccServer(t)
+	accountTable := `
+    "public.account" = {
+      name   = "account"
+      schema = "public"
+    }`
+	companyTable := `
+    "public.company" = {
+      name                = "company"
+      schema              = "public"
+      enable_history_mode = true
+    }`
+
+	tfresource.Test(t, tfresource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(server),
+		Steps: []tfresource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + testAccPipelineConfig(accountTable, ""),
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckResourceAttr("artie_pipeline.test", "name", "Postgres to Snowflake"),
+					tfresource.TestCheckResourceAttr("artie_pipeline.test", "tables.%", "1"),
+					tfresource.TestCheckResourceAttrSet("artie_pipeline.test", "tables.public.account.uuid"),
+					tfresource.TestCheckResourceAttrPair("artie_pipeline.test", "source_reader_uuid", "artie_source_reader.postgres", "uuid"),
+					tfresource.TestCheckResourceAttrPair("artie_pipeline.test", "destination_connector_uuid", "artie_connector.snowflake", "uuid"),
+					tfresource.TestCheckResourceAttr("artie_pipeline.test", "source_type", "postgresql"),
+					tfresource.TestCheckResourceAttr("artie_pipeline.test", "status", "running"),
+					tfresource.TestCheckResourceAttrSet("artie_pipeline.test", "last_updated_at"),
+					tfresource.TestCheckResourceAttr("artie_pipeline.test", "tables.public.account.status", "running"),
+					tfresource.TestCheckNoResourceAttr("artie_pipeline.test", "tables.public.account.history_table_status"),
+				),
+			},
+			{
+				Config: testAccProviderConfig(server) + testAccPipelineConfig(accountTable+companyTable, ""),
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckResourceAttr("artie_pipeline.test", "tables.%", "2"),
+					tfresource.TestCheckResourceAttr("artie_pipeline.test", "tables.public.company.enable_history_mode", "true"),
+					tfresource.TestCheckResourceAttrSet("artie_pipeline.test", "tables.public.company.uuid"),
+					tfresource.TestCheckResourceAttr("artie_pipeline.test", "tables.public.company.history_table_status", "running"),
+				),
+			},
+			{
+				ResourceName:                         "artie_pipeline.test",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "uuid",
+				ImportStateIdFunc:                    testAccImportStateUUID("artie_pipeline.test"),
+			},
+		},
+	})
+}
+
+func TestAccPipelineResource_WaitForDeployment(t *testing.T) {
+	server := newTestAccServer(t)
+	accountTable := `
+    "public.account" = {
+      name   = "account"
+      schema = "public"
+    }`
+	settings := `
+  wait_for_deployment = true
+  timeouts {
+    create = "5m"
+    update = "5m"
+  }`
+
+	tfresource.Test(t, tfresource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(server),
+		Steps: []tfresource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + testAccPipelineConfig(accountTable, settings),
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckResourceAttr("artie_pipeline.test", "wait_for_deployment", "true"),
+					tfresource.TestCheckResourceAttr("artie_pipeline.test", "timeouts.create", "5m"),
+					tfresource.TestCheckResourceAttr("artie_pipeline.test", "is_deploying", "false"),
+					tfresource.TestCheckResourceAttr("artie_pipeline.test", "has_undeployed_changes", "false"),
+					tfresource.TestCheckResourceAttrSet("artie_pipeline.test", "last_deployed_at"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPipelineResource_StartBehavior(t *testing.T) {
+	server := newTestAccServer(t)
+	accountTable := `
+    "public.account" = {
+      name   = "account"
+      schema = "public"
+    }`
+	startBehavior := func(keepPaused bool) string {
+		return fmt.Sprintf(`
+  start_behavior = {
+    skip_backfill = true
+    keep_paused   = %t
+  }`, keepPaused)
+	}
+
+	tfresource.Test(t, tfresource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(server),
+		Steps: []tfresource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + testAccPipelineConfig(accountTable, startBehavior(true)),
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckResourceAttr("artie_pipeline.test", "status", "paused"),
+					tfresource.TestCheckResourceAttr("artie_pipeline.test", "has_backfilling_tables", "false"),
+					tfresource.TestCheckResourceAttr("artie_pipeline.test", "start_behavior.keep_paused", "true"),
+				),
+			},
+			{
+				Config: testAccProviderConfig(server) + testAccPipelineConfig(accountTable, startBehavior(false)),
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckResourceAttr("artie_pipeline.test", "status", "running"),
+					tfresource.TestCheckResourceAttr("artie_pipeline.test", "has_backfilling_tables", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPipelineResource_DesiredStatus(t *testing.T) {
+	server := newTestAccServer(t)
+	accountTable := `
+    "public.account" = {
+      name   = "account"
+      schema = "public"
+    }`
+	desiredStatus := func(status string) string {
+		return fmt.Sprintf(`
+  desired_status = %q`, status)
+	}
+
+	var pipelineUUID string
+	tfresource.Test(t, tfresource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(server),
+		Steps: []tfresource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + testAccPipelineConfig(accountTable, desiredStatus("paused")),
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckResourceAttr("artie_pipeline.test", "desired_status", "paused"),
+					tfresource.TestCheckResourceAttr("artie_pipeline.test", "status", "paused"),
+					tfresource.TestCheckResourceAttr("artie_pipeline.test", "has_backfilling_tables", "false"),
+					func(s *terraform.State) error {
+						pipelineUUID = s.RootModule().Resources["artie_pipeline.test"].Primary.Attributes["uuid"]
+						return nil
+					},
+				),
+			},
+			{
+				Config: testAccProviderConfig(server) + testAccPipelineConfig(accountTable, desiredStatus("transfer paused")),
+				Check:  tfresource.TestCheckResourceAttr("artie_pipeline.test", "status", "transfer paused"),
+			},
+			{
+				Config: testAccProviderConfig(server) + testAccPipelineConfig(accountTable, desiredStatus("running")),
+				Check:  tfresource.TestCheckResourceAttr("artie_pipeline.test", "status", "running"),
+			},
+			{
+				// Pausing the pipeline outside of Terraform shows up as drift.
+				PreConfig: func() {
+					ctx := t.Context()
+					r := &PipelineResource{}
+					configureTestResource(t, r, server.URL)
+					pipelines := r.client.Pipelines(r.openAPIClient)
+					require.NoError(t, pipelines.UpdateStatus(ctx, pipelineUUID, "paused"))
+					_, err := pipelines.Get(ctx, pipelineUUID)
+					require.NoError(t, err)
+				},
+				Config:             testAccProviderConfig(server) + testAccPipelineConfig(accountTable, desiredStatus("running")),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccProviderConfig(server) + testAccPipelineConfig(accountTable, desiredStatus("running")),
+				Check:  tfresource.TestCheckResourceAttr("artie_pipeline.test", "status", "running"),
+			},
+		},
+	})
+}
+
+func TestAccPipelineResource_ValidateOnPlan(t *testing.T) {
+	server := newTestAccServer(t)
+	accountTable := `
+    "public.account" = {
+      name   = "account"
+      schema = "public"
+    }`
+	typoTable := `
+    "public.acount" = {
+      name   = "acount"
+      schema = "public"
+    }`
+	withoutValidation := strings.Replace(testAccProviderConfig(server), "max_retries = 0", "max_retries = 0\n  validate_pipelines_on_plan = false", 1)
+
+	var sourceUUID string
+	tfresource.Test(t, tfresource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(server),
+		Steps: []tfresource.TestStep{
+			{
+				// The source reader and destination don't exist yet, so the pipeline can only be validated on apply.
+				Config: testAccProviderConfig(server) + testAccPipelineConfig(accountTable, ""),
+				Check: func(s *terraform.State) error {
+					sourceUUID = s.RootModule().Resources["artie_connector.postgres"].Primary.Attributes["uuid"]
+					return nil
+				},
+			},
+			{
+				PreConfig: func() {
+					server.SetCatalog(uuid.MustParse(sourceUUID), artiefake.Catalog{
+						"customers": {{Name: lib.ToPtr("account"), Schema: lib.ToPtr("public")}},
+					})
+				},
+				Config:      testAccProviderConfig(server) + testAccPipelineConfig(accountTable+typoTable, ""),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`table "public.acount" does not exist in database "customers"`),
+			},
+			{
+				Config:             withoutValidation + testAccPipelineConfig(accountTable+typoTable, ""),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestPipelineResource_ModifyPlan(t *testing.T) {
+	ctx := t.Context()
+	server := newTestAccServer(t)
+	recorder := newRequestRecorder(t, server.URL)
+	newResource := func(validateOnPlan bool) *PipelineResource {
+		providerData := newTestProviderData(recorder.URL)
+		providerData.ValidatePipelinesOnPlan = validateOnPlan
+		r := &PipelineResource{}
+		var resp resource.ConfigureResponse
+		r.Configure(ctx, resource.ConfigureRequest{ProviderData: providerData}, &resp)
+		require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+		return r
+	}
+	// modifyPlan plans config, which is a new pipeline if state is null, and returns the diagnostics and the requests
+	// that were sent to the API.
+	modifyPlan := func(r *PipelineResource, state tfsdk.State, config tfsdk.Plan) (diag.Diagnostics, []string) {
+		requestCount := len(recorder.Requests())
+		resp := resource.ModifyPlanResponse{Plan: config}
+		r.ModifyPlan(ctx, resource.ModifyPlanRequest{State: state, Plan: config, Config: tfsdk.Config(config)}, &resp)
+		return resp.Diagnostics, recorder.Requests()[requestCount:]
+	}
+	// validationRequests returns the requests that asked Artie to validate a pipeline.
+	validationRequests := func(requests []string) []string {
+		return slices.DeleteFunc(requests, func(request string) bool {
+			return !strings.Contains(request, "/pipelines/validate-unsaved")
+		})
+	}
+
+	pipeline := createTestPipeline(t, server, "modify plan")
+	state := readTestPipelineState(t, newResource(true), pipeline.UUID.String())
+	newPipeline := tfsdk.State{Schema: state.Schema, Raw: tftypes.NewValue(state.Schema.Type().TerraformType(ctx), nil)}
+	renamed := planFromState(t, state, map[string]any{"name": "renamed"})
+	{
+		diags, requests := modifyPlan(newResource(true), newPipeline, tfsdk.Plan(state))
+		assert.False(t, diags.HasError(), diags)
+		assert.NotEmpty(t, validationRequests(requests))
+	}
+	{
+		// Pipelines that haven't changed aren't validated.
+		diags, requests := modifyPlan(newResource(true), state, tfsdk.Plan(state))
+		assert.False(t, diags.HasError(), diags)
+		assert.Empty(t, requests)
+	}
+	{
+		// Pipelines whose config depends on resources that haven't been created yet aren't validated.
+		config := planFromState(t, state, map[string]any{"source_reader_uuid": types.StringUnknown()})
+		diags, requests := modifyPlan(newResource(true), state, config)
+		assert.False(t, diags.HasError(), diags)
+		assert.Empty(t, validationRequests(requests))
+	}
+
+	// The pipeline's table doesn't exist in the source.
+	sourceReader, err := artieclient.NewSourceReaderClient(newResource(true).openAPIClient).Get(ctx, pipeline.SourceReaderUUID.String())
+	require.NoError(t, err)
+	server.SetCatalog(sourceReader.ConnectorUUID, artiefake.Catalog{"": {{Name: lib.ToPtr("customer"), Schema: lib.ToPtr("public")}}})
+	{
+		diags, _ := modifyPlan(newResource(true), state, renamed)
+		require.True(t, diags.HasError())
+		assert.Equal(t, "Pipeline source validation failed", diags[0].Summary())
+		assert.Contains(t, diags[0].Detail(), `table "public.account" does not exist`)
+		withPath, ok := diags[0].(diag.DiagnosticWithPath)
+		require.True(t, ok)
+		assert.Equal(t, path.Root("tables").AtMapKey("public.account"), withPath.Path())
+	}
+	{
+		// Validation can be turned off for the provider, in which case the API isn't called at all.
+		diags, requests := modifyPlan(newResource(false), state, renamed)
+		assert.False(t, diags.HasError(), diags)
+		assert.Empty(t, requests)
+	}
+
+	// Tables are checked against the source's type, since ValidateConfig can only check Redis tables that have a key
+	// pattern.
+	client := newResource(true).client
+	redis, err := client.Connectors().Create(ctx, artieclient.BaseConnector{Type: connectors.Redis, Label: "redis"})
+	require.NoError(t, err)
+	redisReader, err := artieclient.NewSourceReaderClient(newResource(true).openAPIClient).Create(ctx, openapi.RouterSourceReaderCreateRequest{
+		ConnectorUUID: redis.UUID,
+		Name:          lib.ToPtr("redis"),
+	})
+	require.NoError(t, err)
+	redisPipeline := planFromState(t, state, map[string]any{"source_reader_uuid": redisReader.Uuid.String()})
+	ctidBackfillPath := path.Root("tables").AtMapKey("public.account").AtName("ctid_backfill")
+	require.False(t, redisPipeline.SetAttribute(ctx, ctidBackfillPath, true).HasError())
+	{
+		diags, _ := modifyPlan(newResource(true), state, redisPipeline)
+		require.True(t, diags.HasError())
+		assert.True(t, slices.ContainsFunc(diags, func(d diag.Diagnostic) bool {
+			withPath, ok := d.(diag.DiagnosticWithPath)
+			return ok && withPath.Path().Equal(ctidBackfillPath)
+		}), diags)
+	}
+}
+
+func TestValidationErrorPath(t *testing.T) {
+	tables := map[string]tfmodels.Table{
+		"public.account":       {Name: types.StringValue("account"), Schema: types.StringValue("public")},
+		"public.account_notes": {Name: types.StringValue("account_notes"), Schema: types.StringValue("public")},
+		"audit.account":        {Name: types.StringValue("account"), Schema: types.StringValue("audit")},
+	}
+	fallback := path.Root("source_reader_uuid")
+	{
+		err := errors.New(`source validation failed: table "public.account_notes" does not exist`)
+		assert.Equal(t, path.Root("tables").AtMapKey("public.account_notes"), validationErrorPath(err, tables, fallback))
+	}
+	{
+		err := errors.New(`source validation failed: table "account_notes" is missing a primary key`)
+		assert.Equal(t, path.Root("tables").AtMapKey("public.account_notes"), validationErrorPath(err, tables, fallback))
+	}
+	{
+		// Tables with the same name in different schemas can't be told apart.
+		err := errors.New(`source validation failed: table "account" is missing a primary key`)
+		assert.Equal(t, fallback, validationErrorPath(err, tables, fallback))
+	}
+	{
+		err := errors.New("source validation failed: replication slot is missing")
+		assert.Equal(t, fallback, validationErrorPath(err, tables, fallback))
+	}
+}
+
+func TestValidateDestinationConfig(t *testing.T) {
+	{
+		// Destinations without extra requirements are left to the API.
+		diags := validateDestinationConfig(connectors.Snowflake, &tfmodels.PipelineDestinationConfig{})
+		assert.False(t, diags.HasError())
+	}
+	{
+		diags := validateDestinationConfig(connectors.MotherDuck, &tfmodels.PipelineDestinationConfig{})
+		require.Len(t, diags.Errors(), 2)
+		assert.Equal(t, "database is required for MotherDuck", diags.Errors()[0].Summary())
+		assert.Equal(t, "schema is required for MotherDuck", diags.Errors()[1].Summary())
+	}
+	{
+		diags := validateDestinationConfig(connectors.MotherDuck, nil)
+		assert.Len(t, diags.Errors(), 2)
+	}
+	{
+		diags := validateDestinationConfig(connectors.MotherDuck, &tfmodels.PipelineDestinationConfig{
+			Database: types.StringValue("analytics"),
+			Schema:   types.StringValue("main"),
+		})
+		assert.False(t, diags.HasError())
+	}
+	{
+		diags := validateDestinationConfig(connectors.MotherDuck, &tfmodels.PipelineDestinationConfig{
+			Database:              types.StringValue("analytics"),
+			UseSameSchemaAsSource: types.BoolValue(true),
+		})
+		assert.False(t, diags.HasError())
+	}
+	{
+		diags := validateDestinationConfig(connectors.ClickHouse, &tfmodels.PipelineDestinationConfig{Database: types.StringValue("")})
+		require.Len(t, diags.Errors(), 1)
+		assert.Equal(t, "database is required for ClickHouse", diags.Errors()[0].Summary())
+
+		diags = validateDestinationConfig(connectors.ClickHouse, &tfmodels.PipelineDestinationConfig{Database: types.StringValue("analytics")})
+		assert.False(t, diags.HasError())
+		diags = validateDestinationConfig(connectors.ClickHouse, &tfmodels.PipelineDestinationConfig{
+			Database:              types.StringValue(""),
+			UseSameSchemaAsSource: types.BoolValue(true),
+		})
+		assert.False(t, diags.HasError())
+	}
+	{
+		diags := validateDestinationConfig(connectors.Delta, &tfmodels.PipelineDestinationConfig{Bucket: types.StringValue("lake"), Folder: types.StringValue("artie/raw"), TableNameSeparator: types.StringValue("__")})
+		assert.False(t, diags.HasError())
+	}
+	{
+		diags := validateDestinationConfig(connectors.Delta, &tfmodels.PipelineDestinationConfig{Folder: types.StringValue("/artie/"), TableNameSeparator: types.StringValue("/")})
+		require.Len(t, diags.Errors(), 3)
+		assert.Equal(t, "bucket is required for Delta Lake", diags.Errors()[0].Summary())
+		assert.Equal(t, "Invalid folder for Delta Lake", diags.Errors()[1].Summary())
+		assert.Equal(t, "Invalid table_name_separator for Delta Lake", diags.Errors()[2].Summary())
+	}
+	{
+		// Unknown values can't be checked until apply.
+		diags := validateDestinationConfig(connectors.MotherDuck, &tfmodels.PipelineDestinationConfig{
+			Database: types.StringUnknown(),
+			Schema:   types.StringUnknown(),
+		})
+		assert.False(t, diags.HasError())
+	}
+}
+
+func TestValidateTablesForSource(t *testing.T) {
+	{
+		tables := map[string]tfmodels.Table{
+			"user": {Name: types.StringValue("user"), RedisKeyPattern: types.StringValue("user:*"), CTIDBackfill: types.BoolValue(false)},
+		}
+		diags := validateTablesForSource(connectors.Redis, tables)
+		assert.False(t, diags.HasError())
+	}
+	{
+		tables := map[string]tfmodels.Table{
+			"public.user": {
+				Name:               types.StringValue("user"),
+				Schema:             types.StringValue("public"),
+				CTIDBackfill:       types.BoolValue(true),
+				UnifyAcrossSchemas: types.BoolValue(true),
+			},
+		}
+		diags := validateTablesForSource(connectors.Redis, tables)
+		require.Len(t, diags.Errors(), 3)
+		assert.Contains(t, diags.Errors()[0].Detail(), "should not have `schema` set because Redis doesn't have schemas")
+		assert.Contains(t, diags.Errors()[1].Detail(), "should not have `ctid_backfill` set")
+		assert.Contains(t, diags.Errors()[2].Detail(), "should not have `unify_across_schemas` set")
+	}
+	{
+		tables := map[string]tfmodels.Table{
+			"public.user": {Name: types.StringValue("user"), Schema: types.StringValue("public"), RedisKeyPattern: types.StringValue("user:*")},
+		}
+		diags := validateTablesForSource(connectors.PostgreSQL, tables)
+		require.Len(t, diags.Errors(), 1)
+		assert.Contains(t, diags.Errors()[0].Detail(), "should not have `redis_key_pattern` set because it is only applicable if the source type is Redis")
+	}
+	{
+		tables := map[string]tfmodels.Table{
+			"public.user": {Name: types.StringValue("user"), Schema: types.StringValue("public"), CTIDBackfill: types.BoolValue(true)},
+		}
+		diags := validateTablesForSource(connectors.PostgreSQL, tables)
+		assert.False(t, diags.HasError())
+	}
+}
+
+func TestValidateStartBehavior(t *testing.T) {
+	tables := map[string]tfmodels.Table{
+		"public.account": {Name: types.StringValue("account"), Schema: types.StringValue("public"), CTIDBackfill: types.BoolValue(true)},
+		"public.company": {Name: types.StringValue("company"), Schema: types.StringValue("public"), BackfillHistoryTable: types.BoolValue(true), RangeBackfill: types.BoolValue(false)},
+	}
+	{
+		// Without start_behavior, there's nothing to check.
+		pipeline := tfmodels.Pipeline{StatusOverride: types.StringValue("paused")}
+		assert.False(t, validateStartBehavior(pipeline, tables).HasError())
+	}
+	{
+		pipeline := tfmodels.Pipeline{StartBehavior: &tfmodels.PipelineStartBehavior{KeepPaused: types.BoolValue(true)}}
+		assert.False(t, validateStartBehavior(pipeline, tables).HasError())
+	}
+	{
+		pipeline := tfmodels.Pipeline{
+			StatusOverride: types.StringValue("paused"),
+			StartBehavior:  &tfmodels.PipelineStartBehavior{KeepPaused: types.BoolValue(true)},
+		}
+		diags := validateStartBehavior(pipeline, tables)
+		require.Len(t, diags.Errors(), 1)
+		assert.Contains(t, diags.Errors()[0].Detail(), "can't be set together with `status_override`")
+	}
+	{
+		pipeline := tfmodels.Pipeline{
+			DesiredStatus: types.StringValue("running"),
+			StartBehavior: &tfmodels.PipelineStartBehavior{KeepPaused: types.BoolValue(true)},
+		}
+		diags := validateStartBehavior(pipeline, tables)
+		require.Len(t, diags.Errors(), 1)
+		assert.Contains(t, diags.Errors()[0].Detail(), "can't be true when `desired_status` is \"running\"")
+	}
+	{
+		pipeline := tfmodels.Pipeline{
+			DesiredStatus: types.StringValue("paused"),
+			StartBehavior: &tfmodels.PipelineStartBehavior{KeepPaused: types.BoolValue(true)},
+		}
+		assert.False(t, validateStartBehavior(pipeline, tables).HasError())
+	}
+	{
+		pipeline := tfmodels.Pipeline{StartBehavior: &tfmodels.PipelineStartBehavior{SkipBackfill: types.BoolValue(true)}}
+		diags := validateStartBehavior(pipeline, tables)
+		require.Len(t, diags.Errors(), 2)
+		assert.Equal(t, `"public.account" table should not have `+"`ctid_backfill`"+` set because `+"`start_behavior.skip_backfill`"+` is true.`, diags.Errors()[0].Detail())
+		assert.Contains(t, diags.Errors()[1].Detail(), `"public.company" table should not have `+"`backfill_history_table`")
+	}
+}
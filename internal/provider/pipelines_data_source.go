@@ -0,0 +1,197 @@
+package provider
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"terraform-provider-artie/internal/artieclient"
+	"terraform-provider-artie/internal/openapi"
+	"terraform-provider-artie/internal/provider/connectors"
+	"terraform-provider-artie/internal/provider/tfmodels"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &PipelinesDataSource{}
+var _ datasource.DataSourceWithConfigure = &PipelinesDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &PipelinesDataSource{}
+
+var pipelineStatuses = []string{
+	string(openapi.EnumsPipelineStatusDraft),
+	string(openapi.EnumsPipelineStatusPaused),
+	string(openapi.EnumsPipelineStatusRunning),
+	string(openapi.EnumsPipelineStatusTransferPaused),
+}
+
+func NewPipelinesDataSource() datasource.DataSource {
+	return &PipelinesDataSource{}
+}
+
+type PipelinesDataSource struct {
+	client        artieclient.Client
+	openAPIClient *openapi.ClientWithResponses
+}
+
+// pipelineFilter holds the filters of the artie_pipelines data source. Empty filters match every pipeline.
+type pipelineFilter struct {
+	nameRegex     *regexp.Regexp
+	status        string
+	sourceType    string
+	dataPlaneName string
+}
+
+func (f pipelineFilter) matches(pipeline openapi.PayloadsLightPipeline) bool {
+	if f.nameRegex != nil && !f.nameRegex.MatchString(pipeline.Name) {
+		return false
+	}
+	if f.status != "" && (pipeline.Status == nil || string(*pipeline.Status) != f.status) {
+		return false
+	}
+	if f.sourceType != "" && string(pipeline.SourceType) != f.sourceType {
+		return false
+	}
+	if f.dataPlaneName != "" && pipeline.DataPlaneName != f.dataPlaneName {
+		return false
+	}
+	return true
+}
+
+// filterPipelines returns the pipelines that match filter, sorted by name so that the data source's result is stable.
+func filterPipelines(pipelines []openapi.PayloadsLightPipeline, filter pipelineFilter) []openapi.PayloadsLightPipeline {
+	var matches []openapi.PayloadsLightPipeline
+	for _, pipeline := range pipelines {
+		if filter.matches(pipeline) {
+			matches = append(matches, pipeline)
+		}
+	}
+	slices.SortStableFunc(matches, func(a, b openapi.PayloadsLightPipeline) int {
+		return cmp.Or(cmp.Compare(a.Name, b.Name), cmp.Compare(a.Uuid.String(), b.Uuid.String()))
+	})
+	return matches
+}
+
+func (d *PipelinesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pipelines"
+}
+
+func (d *PipelinesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Artie Pipelines data source. This lists the pipelines in your account, optionally filtered by name, status, source type or data plane.",
+		Attributes: map[string]schema.Attribute{
+			"name_regex": schema.StringAttribute{Optional: true, MarkdownDescription: "If set, only pipelines whose names match this regular expression ([RE2 syntax](https://github.com/google/re2/wiki/Syntax)) are returned."},
+			"status": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "If set, only pipelines with this status are returned. This must be one of `draft`, `paused`, `running` or `transfer paused`.",
+				Validators:          []validator.String{stringvalidator.OneOf(pipelineStatuses...)},
+			},
+			"source_type": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "If set, only pipelines whose source connector is of this type (e.g. `postgresql`) are returned.",
+				Validators:          []validator.String{stringvalidator.OneOf(connectors.SourceTypes()...)},
+			},
+			"data_plane_name": schema.StringAttribute{Optional: true, MarkdownDescription: "If set, only pipelines in this data plane are returned."},
+			"pipelines": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The pipelines that match the filters, sorted by name.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"uuid":                       schema.StringAttribute{Computed: true, MarkdownDescription: "The UUID of the pipeline."},
+						"name":                       schema.StringAttribute{Computed: true, MarkdownDescription: "The human-readable name of the pipeline."},
+						"status":                     schema.StringAttribute{Computed: true, MarkdownDescription: "The status of the pipeline, e.g. `running` or `paused`."},
+						"source_type":                schema.StringAttribute{Computed: true, MarkdownDescription: "The type of the pipeline's source connector."},
+						"source_reader_uuid":         schema.StringAttribute{Computed: true, MarkdownDescription: "The UUID of the source reader that the pipeline reads from."},
+						"destination_connector_uuid": schema.StringAttribute{Computed: true, MarkdownDescription: "The UUID of the connector that the pipeline writes to."},
+						"data_plane_name":            schema.StringAttribute{Computed: true, MarkdownDescription: "The name of the data plane the pipeline runs in."},
+						"has_undeployed_changes":     schema.BoolAttribute{Computed: true, MarkdownDescription: "Whether the pipeline has changes that haven't been deployed yet."},
+						"is_deploying":               schema.BoolAttribute{Computed: true, MarkdownDescription: "Whether the pipeline is currently being deployed."},
+						"last_deployed_at":           schema.StringAttribute{Computed: true, MarkdownDescription: "When the pipeline was last deployed, in RFC 3339 format. This is null if it has never been deployed."},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *PipelinesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(ArtieProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected ArtieProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData))
+		return
+	}
+
+	client, err := providerData.NewClient()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to build Artie client", err.Error())
+		return
+	}
+
+	openAPIClient, err := providerData.NewOpenAPIClient()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to build Artie client", err.Error())
+		return
+	}
+
+	d.client = client
+	d.openAPIClient = openAPIClient
+}
+
+func (d *PipelinesDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var configData tfmodels.PipelinesDataSource
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if tfmodels.IsKnown(configData.NameRegex) {
+		if _, err := regexp.Compile(configData.NameRegex.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("name_regex"), "Invalid name_regex", err.Error())
+		}
+	}
+}
+
+func (d *PipelinesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var configData tfmodels.PipelinesDataSource
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := pipelineFilter{
+		status:        configData.Status.ValueString(),
+		sourceType:    configData.SourceType.ValueString(),
+		dataPlaneName: configData.DataPlaneName.ValueString(),
+	}
+	if configData.NameRegex.ValueString() != "" {
+		nameRegex, err := regexp.Compile(configData.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("name_regex"), "Invalid name_regex", err.Error())
+			return
+		}
+		filter.nameRegex = nameRegex
+	}
+
+	pipelines, err := d.client.Pipelines(d.openAPIClient).List(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to List Pipelines", err.Error())
+		return
+	}
+
+	configData.Pipelines = []tfmodels.PipelineSummary{}
+	for _, pipeline := range filterPipelines(pipelines, filter) {
+		configData.Pipelines = append(configData.Pipelines, tfmodels.PipelineSummaryFromAPIModel(pipeline))
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, configData)...)
+}
@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"terraform-provider-artie/internal/artieclient"
+	"terraform-provider-artie/internal/artiefake"
+	"terraform-provider-artie/internal/lib"
+	"terraform-provider-artie/internal/openapi"
+	"terraform-provider-artie/internal/provider/connectors"
+	"terraform-provider-artie/internal/provider/tfmodels"
+)
+
+func TestFilterPipelines(t *testing.T) {
+	newPipeline := func(name string, status openapi.EnumsPipelineStatus, sourceType openapi.EnumsConnectorSlug, dataPlaneName string) openapi.PayloadsLightPipeline {
+		return openapi.PayloadsLightPipeline{Uuid: uuid.New(), Name: name, Status: &status, SourceType: sourceType, DataPlaneName: dataPlaneName}
+	}
+	ordersProd := newPipeline("orders-prod", openapi.EnumsPipelineStatusRunning, openapi.Postgresql, "aws-us-east-1")
+	ordersStaging := newPipeline("orders-staging", openapi.EnumsPipelineStatusPaused, openapi.Postgresql, "aws-us-west-2")
+	eventsProd := newPipeline("events-prod", openapi.EnumsPipelineStatusRunning, openapi.Mongodb, "aws-us-east-1")
+	draft := openapi.PayloadsLightPipeline{Uuid: uuid.New(), Name: "draft"}
+	pipelines := []openapi.PayloadsLightPipeline{ordersProd, ordersStaging, eventsProd, draft}
+
+	{
+		// No filters returns everything, sorted by name.
+		assert.Equal(t, []openapi.PayloadsLightPipeline{draft, eventsProd, ordersProd, ordersStaging}, filterPipelines(pipelines, pipelineFilter{}))
+	}
+	{
+		filter := pipelineFilter{nameRegex: regexp.MustCompile("^orders-")}
+		assert.Equal(t, []openapi.PayloadsLightPipeline{ordersProd, ordersStaging}, filterPipelines(pipelines, filter))
+	}
+	{
+		filter := pipelineFilter{status: "running"}
+		assert.Equal(t, []openapi.PayloadsLightPipeline{eventsProd, ordersProd}, filterPipelines(pipelines, filter))
+	}
+	{
+		filter := pipelineFilter{sourceType: "postgresql", dataPlaneName: "aws-us-east-1"}
+		assert.Equal(t, []openapi.PayloadsLightPipeline{ordersProd}, filterPipelines(pipelines, filter))
+	}
+	{
+		filter := pipelineFilter{nameRegex: regexp.MustCompile("prod"), status: "paused"}
+		assert.Empty(t, filterPipelines(pipelines, filter))
+	}
+}
+
+// createTestPipeline creates a Postgres to Snowflake pipeline named name on server.
+func createTestPipeline(t *testing.T, server *artiefake.Server, name string) artieclient.Pipeline {
+	ctx := t.Context()
+	providerData := newTestProviderData(server.URL)
+	client, err := providerData.NewClient()
+	require.NoError(t, err)
+	openAPIClient, err := providerData.NewOpenAPIClient()
+	require.NoError(t, err)
+
+	source, err := client.Connectors().Create(ctx, artieclient.BaseConnector{Type: connectors.PostgreSQL, Label: name + " source"})
+	require.NoError(t, err)
+	destination, err := client.Connectors().Create(ctx, artieclient.BaseConnector{Type: connectors.Snowflake, Label: name + " destination"})
+	require.NoError(t, err)
+	sourceReader, err := artieclient.NewSourceReaderClient(openAPIClient).Create(ctx, openapi.RouterSourceReaderCreateRequest{
+		ConnectorUUID: source.UUID,
+		Name:          lib.ToPtr(name),
+	})
+	require.NoError(t, err)
+	pipeline, err := client.Pipelines(openAPIClient).Create(ctx, artieclient.BasePipeline{
+		Name:             name,
+		SourceReaderUUID: &sourceReader.Uuid,
+		DestinationUUID:  &destination.UUID,
+		Tables:           []artieclient.Table{{Name: "account", Schema: "public"}},
+	})
+	require.NoError(t, err)
+	return pipeline.Pipeline
+}
+
+func TestPipelinesDataSource_Read(t *testing.T) {
+	ctx := t.Context()
+	server := newTestAccServer(t)
+	orders := createTestPipeline(t, server, "orders")
+	createTestPipeline(t, server, "events")
+
+	d := NewPipelinesDataSource()
+	configureTestDataSource(t, d.(*PipelinesDataSource), server.URL)
+	resp := readTestDataSource(t, ctx, d, map[string]any{"name_regex": "^ord", "source_type": "postgresql", "status": "draft"})
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	var state tfmodels.PipelinesDataSource
+	resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	require.Len(t, state.Pipelines, 1)
+	assert.Equal(t, orders.UUID.String(), state.Pipelines[0].UUID.ValueString())
+	assert.Equal(t, "draft", state.Pipelines[0].Status.ValueString())
+	assert.Equal(t, orders.DestinationUUID.String(), state.Pipelines[0].DestinationConnectorUUID.ValueString())
+	assert.False(t, state.Pipelines[0].IsDeploying.ValueBool())
+	assert.True(t, state.Pipelines[0].LastDeployedAt.IsNull())
+}
+
+func TestAccPipelinesDataSource(t *testing.T) {
+	server := newTestAccServer(t)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() { createTestPipeline(t, server, "orders") },
+				Config: testAccProviderConfig(server) + `
+data "artie_pipelines" "postgres" {
+  source_type = "postgresql"
+}
+
+data "artie_pipelines" "none" {
+  name_regex = "^nothing-matches$"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.artie_pipelines.postgres", "pipelines.#", "1"),
+					resource.TestCheckResourceAttr("data.artie_pipelines.postgres", "pipelines.0.name", "orders"),
+					resource.TestCheckResourceAttr("data.artie_pipelines.postgres", "pipelines.0.is_deploying", "false"),
+					resource.TestCheckResourceAttr("data.artie_pipelines.none", "pipelines.#", "0"),
+				),
+			},
+		},
+	})
+}
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"terraform-provider-artie/internal/artieclient"
 	"terraform-provider-artie/internal/provider/tfmodels"
@@ -14,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -125,6 +127,11 @@ func (r *PrivateLinkResource) Read(ctx context.Context, req resource.ReadRequest
 
 	conn, err := r.client.PrivateLinks().Get(ctx, uuid)
 	if err != nil {
+		if errors.As(err, &artieclient.NotFoundError{}) {
+			tflog.Warn(ctx, fmt.Sprintf("PrivateLink connection %s no longer exists, removing it from state", uuid))
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError("Unable to read PrivateLink connection", err.Error())
 		return
 	}
@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestPrivateLinkResource_ReadNotFound(t *testing.T) {
+	testReadRemovesMissingResource(t, NewPrivateLinkResource)
+}
+
+func testAccPrivateLinkConfig(name string) string {
+	return fmt.Sprintf(`
+resource "artie_private_link" "test" {
+  name             = %q
+  vpc_service_name = "com.amazonaws.vpce.us-east-1.vpce-svc-0123456789abcdef0"
+  az_ids           = ["use1-az1", "use1-az2"]
+}
+`, name)
+}
+
+func TestAccPrivateLinkResource(t *testing.T) {
+	server := newTestAccServer(t)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(server),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + testAccPrivateLinkConfig("PrivateLink"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("artie_private_link.test", "name", "PrivateLink"),
+					resource.TestCheckResourceAttr("artie_private_link.test", "region", "us-east-1"),
+					resource.TestCheckResourceAttr("artie_private_link.test", "az_ids.#", "2"),
+					resource.TestCheckResourceAttrSet("artie_private_link.test", "vpc_endpoint_id"),
+					resource.TestCheckResourceAttrSet("artie_private_link.test", "dns_entry"),
+				),
+			},
+			{
+				Config: testAccProviderConfig(server) + testAccPrivateLinkConfig("Renamed PrivateLink"),
+				Check:  resource.TestCheckResourceAttr("artie_private_link.test", "name", "Renamed PrivateLink"),
+			},
+			{
+				ResourceName:                         "artie_private_link.test",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "uuid",
+				ImportStateIdFunc:                    testAccImportStateUUID("artie_private_link.test"),
+			},
+		},
+	})
+}
@@ -7,12 +7,18 @@ import (
 	"strings"
 	"terraform-provider-artie/internal/artieclient"
 	"terraform-provider-artie/internal/openapi"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -32,18 +38,32 @@ type ArtieProvider struct {
 }
 
 type ArtieProviderModel struct {
-	Endpoint types.String `tfsdk:"endpoint"`
-	APIKey   types.String `tfsdk:"api_key"`
+	Endpoint      types.String `tfsdk:"endpoint"`
+	APIKey        types.String `tfsdk:"api_key"`
+	APIKeyFile    types.String `tfsdk:"api_key_file"`
+	APIKeyCommand types.List   `tfsdk:"api_key_command"`
+	MaxRetries    types.Int64  `tfsdk:"max_retries"`
+	RetryMaxWait  types.String `tfsdk:"retry_max_wait"`
+
+	TestConnectionsOnPlan   types.Bool `tfsdk:"test_connections_on_plan"`
+	ValidatePipelinesOnPlan types.Bool `tfsdk:"validate_pipelines_on_plan"`
 }
 
 type ArtieProviderData struct {
-	Endpoint string
-	APIKey   string
-	version  string
+	Endpoint    string
+	APIKey      string
+	RetryConfig artieclient.RetryConfig
+	version     string
+
+	// TestConnectionsOnPlan is the default for the test_connection_on_plan attribute of artie_connector.
+	TestConnectionsOnPlan bool
+	// ValidatePipelinesOnPlan is whether artie_pipeline asks the API to validate its source and destination during plan.
+	ValidatePipelinesOnPlan bool
+	connectorPings          *connectorPingCache
 }
 
 func (a ArtieProviderData) NewClient() (artieclient.Client, error) {
-	return artieclient.New(a.Endpoint, a.APIKey, a.version)
+	return artieclient.New(a.Endpoint, a.APIKey, a.version, a.RetryConfig)
 }
 
 func (a ArtieProviderData) NewOpenAPIClient() (*openapi.ClientWithResponses, error) {
@@ -51,13 +71,16 @@ func (a ArtieProviderData) NewOpenAPIClient() (*openapi.ClientWithResponses, err
 		return nil, fmt.Errorf("artie-client: api key is malformed (should start with arsk_)")
 	}
 
-	return openapi.NewClientWithResponses(a.Endpoint, openapi.WithRequestEditorFn(
-		func(ctx context.Context, req *http.Request) error {
-			req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", a.APIKey))
-			req.Header.Set("User-Agent", "terraform-provider-artie/"+a.version)
-			return nil
-		},
-	))
+	return openapi.NewClientWithResponses(a.Endpoint,
+		openapi.WithHTTPClient(artieclient.NewHTTPClient(a.RetryConfig)),
+		openapi.WithRequestEditorFn(
+			func(ctx context.Context, req *http.Request) error {
+				req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", a.APIKey))
+				req.Header.Set("User-Agent", "terraform-provider-artie/"+a.version)
+				return nil
+			},
+		),
+	)
 }
 
 func (p *ArtieProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -83,13 +106,49 @@ We recommend using [tflint](https://github.com/terraform-linters/tflint) to lint
 `,
 		Attributes: map[string]schema.Attribute{
 			"endpoint": schema.StringAttribute{
-				MarkdownDescription: "Artie API endpoint. This defaults to https://api.artie.com and should not need to be changed except when developing the provider.",
+				MarkdownDescription: fmt.Sprintf("Artie API endpoint. This defaults to https://api.artie.com and should not need to be changed except when developing the provider. Can also be set with the `%s` environment variable.", endpointEnvVar),
 				Optional:            true,
 			},
 			"api_key": schema.StringAttribute{
-				MarkdownDescription: "Artie API key to authenticate requests to the Artie API. Generate an API key in the Artie web app at https://app.artie.com/settings?tab=apiKeys (only company admins can create API keys). We recommend storing this in a secret manager and referencing it via a *sensitive* Terraform variable, instead of putting it in plaintext in your Terraform config file.",
-				Required:            true,
+				MarkdownDescription: fmt.Sprintf("Artie API key to authenticate requests to the Artie API. Generate an API key in the Artie web app at https://app.artie.com/settings?tab=apiKeys (only company admins can create API keys). We recommend storing this in a secret manager and referencing it via a *sensitive* Terraform variable, instead of putting it in plaintext in your Terraform config file. If no API key attribute is set, the provider falls back to the `%s` environment variable.", apiKeyEnvVar),
+				Optional:            true,
 				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("api_key_file"), path.MatchRoot("api_key_command")),
+				},
+			},
+			"api_key_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a file containing the Artie API key, as an alternative to `api_key`. Leading and trailing whitespace is ignored.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("api_key"), path.MatchRoot("api_key_command")),
+				},
+			},
+			"api_key_command": schema.ListAttribute{
+				MarkdownDescription: "A credential helper command that prints the Artie API key to stdout, as an alternative to `api_key`, e.g. `[\"op\", \"read\", \"op://vault/artie/api-key\"]`. The first element is the executable and the rest are its arguments; the command is not run through a shell.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ConflictsWith(path.MatchRoot("api_key"), path.MatchRoot("api_key_file")),
+				},
+			},
+			"max_retries": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("The maximum number of times a request to the Artie API will be retried if it is rate limited (HTTP 429) or fails with a server error. Requests that create new objects are only retried if they were rate limited. Defaults to %d; set to 0 to disable retries.", artieclient.DefaultMaxRetries),
+				Optional:            true,
+				Validators:          []validator.Int64{int64validator.AtLeast(0)},
+			},
+			"retry_max_wait": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("The maximum amount of time to wait between two attempts of a request, as a duration string such as `10s` or `1m`. This also caps any delay requested by the API via a `Retry-After` header. Defaults to `%s`.", artieclient.DefaultRetryMaxWait),
+				Optional:            true,
+			},
+			"test_connections_on_plan": schema.BoolAttribute{
+				MarkdownDescription: "If set to true, connectors that are being created or changed are tested during `terraform plan`, so that problems such as a wrong password are reported before anything is applied. This can be overridden for each connector with its `test_connection_on_plan` attribute. Defaults to false.",
+				Optional:            true,
+			},
+			"validate_pipelines_on_plan": schema.BoolAttribute{
+				MarkdownDescription: "Whether pipelines that are being created or changed have their source and destination validated by Artie during `terraform plan`, so that problems such as a missing table are reported before anything is applied. Validation is skipped if any of a pipeline's settings are only known after apply, and pipelines are always validated again when they're applied. Settings that depend on the type of a pipeline's source or destination, such as the tables of a Redis source, are checked at the same time. Defaults to true; set to false to stop the provider from calling the Artie API for pipelines during plan, and only validate them on apply.",
+				Optional:            true,
 			},
 		},
 	}
@@ -102,15 +161,34 @@ func (p *ArtieProvider) Configure(ctx context.Context, req provider.ConfigureReq
 		return
 	}
 
-	endpoint := DEFAULT_API_ENDPOINT
-	if !configData.Endpoint.IsNull() {
-		endpoint = configData.Endpoint.ValueString()
+	apiKey, diags := resolveAPIKey(ctx, configData)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	retryConfig := artieclient.DefaultRetryConfig()
+	if !configData.MaxRetries.IsNull() {
+		retryConfig.MaxRetries = int(configData.MaxRetries.ValueInt64())
+	}
+	if !configData.RetryMaxWait.IsNull() {
+		maxWait, err := time.ParseDuration(configData.RetryMaxWait.ValueString())
+		if err != nil || maxWait <= 0 {
+			resp.Diagnostics.AddAttributeError(path.Root("retry_max_wait"), "Invalid retry_max_wait", fmt.Sprintf("%q is not a valid positive duration. Please use a value such as `10s` or `1m`.", configData.RetryMaxWait.ValueString()))
+			return
+		}
+		retryConfig.MaxWait = maxWait
 	}
 
 	providerData := ArtieProviderData{
-		Endpoint: endpoint,
-		APIKey:   configData.APIKey.ValueString(),
-		version:  p.version,
+		Endpoint:    resolveEndpoint(configData),
+		APIKey:      apiKey,
+		RetryConfig: retryConfig,
+		version:     p.version,
+
+		TestConnectionsOnPlan:   configData.TestConnectionsOnPlan.ValueBool(),
+		ValidatePipelinesOnPlan: configData.ValidatePipelinesOnPlan.IsNull() || configData.ValidatePipelinesOnPlan.ValueBool(),
+		connectorPings:          newConnectorPingCache(),
 	}
 
 	resp.DataSourceData = providerData
@@ -126,11 +204,18 @@ func (p *ArtieProvider) Resources(ctx context.Context) []func() resource.Resourc
 		NewPrivateLinkResource,
 		NewEncryptionKeyResource,
 		NewColumnHashingSaltResource,
+		NewIngestionAPIKeyResource,
 	}
 }
 
 func (p *ArtieProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{}
+	return []func() datasource.DataSource{
+		NewConnectorDataSource,
+		NewPipelinesDataSource,
+		NewConnectorDatabasesDataSource,
+		NewConnectorSchemasDataSource,
+		NewConnectorTablesDataSource,
+	}
 }
 
 func (p *ArtieProvider) Functions(ctx context.Context) []func() function.Function {
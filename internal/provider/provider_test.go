@@ -1,14 +1,60 @@
 package provider
 
-// import (
-// 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
-// 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
-// )
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"terraform-provider-artie/internal/artiefake"
+)
 
 // testAccProtoV6ProviderFactories are used to instantiate a provider during
 // acceptance testing. The factory function will be invoked for every Terraform
 // CLI command executed to create a provider server to which the CLI can
 // reattach.
-// var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
-// 	"artie": providerserver.NewProtocol6WithError(New("test")()),
-// }
+var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
+	"artie": providerserver.NewProtocol6WithError(New("test")()),
+}
+
+// newTestAccServer starts a fake Artie API server that's shut down when the test finishes. Acceptance tests run
+// against it instead of the real API, so they don't need an Artie account.
+func newTestAccServer(t *testing.T) *artiefake.Server {
+	server := artiefake.NewServer()
+	t.Cleanup(server.Close)
+	return server
+}
+
+// testAccProviderConfig returns a provider block that points at server.
+func testAccProviderConfig(server *artiefake.Server) string {
+	return fmt.Sprintf(`
+provider "artie" {
+  endpoint    = %q
+  api_key     = %q
+  max_retries = 0
+}
+`, server.URL, artiefake.APIKey)
+}
+
+// testAccCheckDestroy checks that every object was deleted from server once a test case is destroyed.
+func testAccCheckDestroy(server *artiefake.Server) func(*terraform.State) error {
+	return func(*terraform.State) error {
+		if count := server.ObjectCount(); count != 0 {
+			return fmt.Errorf("%d objects still exist after destroy", count)
+		}
+		return nil
+	}
+}
+
+// testAccImportStateUUID returns the uuid of resourceName, which is the ID used to import every Artie resource.
+func testAccImportStateUUID(resourceName string) func(*terraform.State) (string, error) {
+	return func(state *terraform.State) (string, error) {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource %s not found in state", resourceName)
+		}
+		return rs.Primary.Attributes["uuid"], nil
+	}
+}
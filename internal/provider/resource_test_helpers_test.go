@@ -0,0 +1,154 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/http/httputil"
+	"net/url"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"terraform-provider-artie/internal/artieclient"
+)
+
+// newStubServer starts an HTTP server that responds to every request with the given status code and body.
+func newStubServer(t *testing.T, statusCode int, body string) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+// requestRecorder is an HTTP server that forwards every request to another server, recording each one's method
+// and path.
+type requestRecorder struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	requests []string
+}
+
+// newRequestRecorder starts a requestRecorder that forwards requests to target.
+func newRequestRecorder(t *testing.T, target string) *requestRecorder {
+	targetURL, err := url.Parse(target)
+	require.NoError(t, err)
+	proxy := httputil.NewSingleHostReverseProxy(targetURL)
+
+	recorder := &requestRecorder{}
+	recorder.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		recorder.mu.Lock()
+		recorder.requests = append(recorder.requests, r.Method+" "+r.URL.Path)
+		recorder.mu.Unlock()
+		proxy.ServeHTTP(w, r)
+	}))
+	t.Cleanup(recorder.Close)
+	return recorder
+}
+
+// Requests returns the method and path of every request received so far, in order.
+func (r *requestRecorder) Requests() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return slices.Clone(r.requests)
+}
+
+// newTestProviderData returns provider data for a client that sends requests to endpoint without retrying.
+func newTestProviderData(endpoint string) ArtieProviderData {
+	return ArtieProviderData{
+		Endpoint:    endpoint,
+		APIKey:      "arsk_test",
+		RetryConfig: artieclient.RetryConfig{MaxRetries: 0},
+		version:     "test",
+
+		connectorPings: newConnectorPingCache(),
+	}
+}
+
+// configureTestResource configures r with a client that sends requests to endpoint without retrying.
+func configureTestResource(t *testing.T, r resource.ResourceWithConfigure, endpoint string) {
+	var resp resource.ConfigureResponse
+	r.Configure(t.Context(), resource.ConfigureRequest{ProviderData: newTestProviderData(endpoint)}, &resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+}
+
+// configureTestDataSource configures d with a client that sends requests to endpoint without retrying.
+func configureTestDataSource(t *testing.T, d datasource.DataSourceWithConfigure, endpoint string) {
+	var resp datasource.ConfigureResponse
+	d.Configure(t.Context(), datasource.ConfigureRequest{ProviderData: newTestProviderData(endpoint)}, &resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+}
+
+// readTestDataSource reads d with a config where only the given top-level attributes are set.
+func readTestDataSource(t *testing.T, ctx context.Context, d datasource.DataSource, attributes map[string]any) datasource.ReadResponse {
+	var schemaResp datasource.SchemaResponse
+	d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError(), schemaResp.Diagnostics)
+
+	config := tfsdk.Config{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	state := tfsdk.State{Schema: config.Schema, Raw: config.Raw}
+	for name, value := range attributes {
+		diags := state.SetAttribute(ctx, path.Root(name), value)
+		require.False(t, diags.HasError(), diags)
+	}
+	config.Raw = state.Raw
+
+	resp := datasource.ReadResponse{State: state}
+	d.Read(ctx, datasource.ReadRequest{Config: config}, &resp)
+	return resp
+}
+
+// newTestState returns a state for r's schema where only the uuid attribute is set.
+func newTestState(t *testing.T, ctx context.Context, r resource.Resource, uuid string) tfsdk.State {
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError(), schemaResp.Diagnostics)
+
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	diags := state.SetAttribute(ctx, path.Root("uuid"), uuid)
+	require.False(t, diags.HasError(), diags)
+	return state
+}
+
+// testReadRemovesMissingResource checks that Read removes r from state when the API responds with a 404, and that
+// any other error is still reported.
+func testReadRemovesMissingResource(t *testing.T, newResource func() resource.Resource) {
+	const objectUUID = "0f7e2d9c-4b1a-4c7e-9d3f-5a6b7c8d9e0f"
+	ctx := t.Context()
+	{
+		r := newResource()
+		configureTestResource(t, r.(resource.ResourceWithConfigure), newStubServer(t, http.StatusNotFound, `{"error": "not found"}`).URL)
+		state := newTestState(t, ctx, r, objectUUID)
+		resp := resource.ReadResponse{State: state}
+		r.Read(ctx, resource.ReadRequest{State: state}, &resp)
+		assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+		assert.True(t, resp.State.Raw.IsNull())
+	}
+	{
+		r := newResource()
+		configureTestResource(t, r.(resource.ResourceWithConfigure), newStubServer(t, http.StatusInternalServerError, `{"error": "oops"}`).URL)
+		state := newTestState(t, ctx, r, objectUUID)
+		resp := resource.ReadResponse{State: state}
+		r.Read(ctx, resource.ReadRequest{State: state}, &resp)
+		assert.True(t, resp.Diagnostics.HasError())
+		assert.False(t, resp.State.Raw.IsNull())
+	}
+}
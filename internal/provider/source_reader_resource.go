@@ -2,12 +2,15 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"maps"
 	"slices"
 
 	"terraform-provider-artie/internal/artieclient"
 	"terraform-provider-artie/internal/lib"
 	"terraform-provider-artie/internal/openapi"
+	"terraform-provider-artie/internal/provider/connectors"
 	"terraform-provider-artie/internal/provider/tfmodels"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -25,18 +28,21 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &SourceReaderResource{}
 var _ resource.ResourceWithConfigure = &SourceReaderResource{}
 var _ resource.ResourceWithImportState = &SourceReaderResource{}
+var _ resource.ResourceWithModifyPlan = &SourceReaderResource{}
 
 func NewSourceReaderResource() resource.Resource {
 	return &SourceReaderResource{}
 }
 
 type SourceReaderResource struct {
+	client        artieclient.Client
 	sourceReaders artieclient.SourceReaderClient
 }
 
@@ -57,23 +63,25 @@ func (r *SourceReaderResource) Schema(ctx context.Context, req resource.SchemaRe
 				Computed:            true,
 				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
-			"is_shared":                          schema.BoolAttribute{Optional: true, Computed: true, PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()}, MarkdownDescription: "If set to true, this source reader can be used by multiple pipelines."},
-			"database_name":                      schema.StringAttribute{Optional: true, Computed: true, Default: stringdefault.StaticString(""), PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}, MarkdownDescription: "The name of the database we should read data from in the source connector. This should be specified if the source connector's type is DocumentDB, MongoDB, MySQL, MS SQL, Oracle (this maps to the service name), or PostgreSQL."},
-			"oracle_container_name":              schema.StringAttribute{Optional: true, Computed: true, Default: stringdefault.StaticString(""), PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}, MarkdownDescription: "The name of the container (pluggable database) if the source type is Oracle and you are using a container database."},
-			"backfill_batch_size":                schema.Int64Attribute{Optional: true, Computed: true, PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()}, MarkdownDescription: "The number of rows to read from the source database in each batch while backfilling. Maximum allowed value is 50,000. Default is 5,000."},
-			"enable_heartbeats":                  schema.BoolAttribute{Optional: true, Computed: true, PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()}, MarkdownDescription: "If the source database is a very low-traffic PostgreSQL database (e.g., a dev database) and is running on Amazon RDS, we recommend setting this to true to prevent WAL growth issues. This is only applicable if the source type is PostgreSQL."},
-			"one_topic_per_schema":               schema.BoolAttribute{Optional: true, Computed: true, PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()}, MarkdownDescription: "If set to true, Artie will write all incoming CDC events into a single Kafka topic per schema. This is currently only supported if your source is Oracle and your account has this feature enabled."},
-			"postgres_publication_name_override": schema.StringAttribute{Optional: true, Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}, MarkdownDescription: "If set, this will override the name of the PostgreSQL publication. Otherwise, we will use our default value, `dbz_publication`. This is only applicable if the source type is PostgreSQL."},
-			"postgres_publication_mode":          schema.StringAttribute{Optional: true, Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}, MarkdownDescription: "This should be set to `filtered` if the PostgreSQL publication in the source database is not set to include `ALL TABLES`. If that's the case, you will need to explicitly add tables to the publication. Otherwise, this should be set to `\"\"`."},
-			"postgres_replication_slot_override": schema.StringAttribute{Optional: true, Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}, MarkdownDescription: "If set, this will override the name of the PostgreSQL replication slot. Otherwise, we will use our default value, `artie`. This is only applicable if the source type is PostgreSQL."},
-			"publish_via_partition_root":         schema.BoolAttribute{Optional: true, Computed: true, PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()}, MarkdownDescription: "If set to true, changes to partitioned tables will be published using the root partitioned table's identity rather than the actual partition that was changed (The API defaults this to true). This is only applicable if the source type is PostgreSQL."},
-			"composite_types_as_text":            schema.BoolAttribute{Optional: true, Computed: true, PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()}, MarkdownDescription: "If set to true, Postgres composite (row) type columns will be replicated as their text representation (e.g. (book,abc123)) instead of being stored as Base64 encoded strings. This is only applicable if the source type is PostgreSQL. Defaults to false."},
-			"use_advance_on_primary_keep_alive":  schema.BoolAttribute{Optional: true, Computed: true, PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()}, MarkdownDescription: "If set to true, Artie will use the `pg_logical_emit_message` function to advance the replication slot LSN on keepalive messages from the primary. This is only applicable if the source type is PostgreSQL."},
-			"enable_unify_across_schemas":        schema.BoolAttribute{Optional: true, Computed: true, PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()}, MarkdownDescription: "If set to true, you can specify tables that should be generalized to all schemas, meaning we will sync all tables with the same name into the same destination table. This is useful if you have multiple identical schemas and want to fan-in the data. This is only applicable if the source type is PostgreSQL."},
-			"unify_across_schemas_regex":         schema.StringAttribute{Optional: true, Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}, MarkdownDescription: "If unify across schemas is enabled, this is an additional regex pattern that you can use to filter which schemas should be unified. This is only applicable if the source type is PostgreSQL."},
-			"mssql_replication_method":           schema.StringAttribute{Optional: true, Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}, MarkdownDescription: "If unset, we will use the default replication method (Capture Instances). If set to `fn_dblog`, we will stream data from transaction logs via SQL access. This is only applicable if the source type is Microsoft SQL Server."},
-			"enable_unify_across_databases":      schema.BoolAttribute{Optional: true, Computed: true, PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()}, MarkdownDescription: "If set to true, you can specify multiple databases within your Microsoft SQL Server that we should sync data from, and we will unify tables with the same name and schema into a single destination table. This is useful if you have multiple identical databases and want to fan-in the data. This is only applicable if the source type is Microsoft SQL Server and `mssql_replication_method` is set to `fn_dblog` or `change_tracking`."},
-			"databases_to_unify":                 schema.ListAttribute{Optional: true, Computed: true, ElementType: types.StringType, PlanModifiers: []planmodifier.List{listplanmodifier.UseStateForUnknown()}, MarkdownDescription: "If `enable_unify_across_databases` is set to true, this should be a list of databases within your Microsoft SQL Server that we should sync data from. All tables that you opt into being unified should exist in each of these databases. This is only applicable if the source type is Microsoft SQL Server."},
+			"is_shared":                                       schema.BoolAttribute{Optional: true, Computed: true, PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()}, MarkdownDescription: "If set to true, this source reader can be used by multiple pipelines."},
+			"database_name":                                   schema.StringAttribute{Optional: true, Computed: true, Default: stringdefault.StaticString(""), PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}, MarkdownDescription: "The name of the database we should read data from in the source connector. This should be specified if the source connector's type is DocumentDB, MongoDB, MySQL, MS SQL, Oracle (this maps to the service name), PlanetScale (this maps to the Vitess keyspace), or PostgreSQL."},
+			"oracle_container_name":                           schema.StringAttribute{Optional: true, Computed: true, Default: stringdefault.StaticString(""), PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}, MarkdownDescription: "The name of the container (pluggable database) if the source type is Oracle and you are using a container database."},
+			"backfill_batch_size":                             schema.Int64Attribute{Optional: true, Computed: true, PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()}, MarkdownDescription: "The number of rows to read from the source database in each batch while backfilling. Maximum allowed value is 50,000. Default is 5,000."},
+			"enable_heartbeats":                               schema.BoolAttribute{Optional: true, Computed: true, PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()}, MarkdownDescription: "If the source database is a very low-traffic PostgreSQL database (e.g., a dev database) and is running on Amazon RDS, we recommend setting this to true to prevent WAL growth issues. This is only applicable if the source type is PostgreSQL."},
+			"one_topic_per_schema":                            schema.BoolAttribute{Optional: true, Computed: true, PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()}, MarkdownDescription: "If set to true, Artie will write all incoming CDC events into a single Kafka topic per schema. This is currently only supported if your source is Oracle and your account has this feature enabled."},
+			"postgres_publication_name_override":              schema.StringAttribute{Optional: true, Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}, MarkdownDescription: "If set, this will override the name of the PostgreSQL publication. Otherwise, we will use our default value, `dbz_publication`. This is only applicable if the source type is PostgreSQL."},
+			"postgres_publication_mode":                       schema.StringAttribute{Optional: true, Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}, MarkdownDescription: "This should be set to `filtered` if the PostgreSQL publication in the source database is not set to include `ALL TABLES`. If that's the case, you will need to explicitly add tables to the publication. Otherwise, this should be set to `\"\"`."},
+			"postgres_replication_slot_override":              schema.StringAttribute{Optional: true, Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}, MarkdownDescription: "If set, this will override the name of the PostgreSQL replication slot. Otherwise, we will use our default value, `artie`. This is only applicable if the source type is PostgreSQL."},
+			"publish_via_partition_root":                      schema.BoolAttribute{Optional: true, Computed: true, PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()}, MarkdownDescription: "If set to true, changes to partitioned tables will be published using the root partitioned table's identity rather than the actual partition that was changed (The API defaults this to true). This is only applicable if the source type is PostgreSQL."},
+			"composite_types_as_text":                         schema.BoolAttribute{Optional: true, Computed: true, PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()}, MarkdownDescription: "If set to true, Postgres composite (row) type columns will be replicated as their text representation (e.g. (book,abc123)) instead of being stored as Base64 encoded strings. This is only applicable if the source type is PostgreSQL. Defaults to false."},
+			"use_advance_on_primary_keep_alive":               schema.BoolAttribute{Optional: true, Computed: true, PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()}, MarkdownDescription: "If set to true, Artie will use the `pg_logical_emit_message` function to advance the replication slot LSN on keepalive messages from the primary. This is only applicable if the source type is PostgreSQL."},
+			"enable_unify_across_schemas":                     schema.BoolAttribute{Optional: true, Computed: true, PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()}, MarkdownDescription: "If set to true, you can specify tables that should be generalized to all schemas, meaning we will sync all tables with the same name into the same destination table. This is useful if you have multiple identical schemas and want to fan-in the data. This is only applicable if the source type is PostgreSQL."},
+			"unify_across_schemas_regex":                      schema.StringAttribute{Optional: true, Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}, MarkdownDescription: "If unify across schemas is enabled, this is an additional regex pattern that you can use to filter which schemas should be unified. This is only applicable if the source type is PostgreSQL."},
+			"mssql_replication_method":                        schema.StringAttribute{Optional: true, Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}, MarkdownDescription: "If unset, we will use the default replication method (Capture Instances). If set to `fn_dblog`, we will stream data from transaction logs via SQL access. This is only applicable if the source type is Microsoft SQL Server."},
+			"mongodb_disable_full_document_before_change":     schema.BoolAttribute{Optional: true, Computed: true, PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()}, MarkdownDescription: "If set to true, Artie will not request change stream pre-images (`fullDocumentBeforeChange`), so delete and update events will not include the document as it was before the change. This is only applicable if the source type is MongoDB. DocumentDB doesn't support pre-images, so this defaults to true and cannot be set to false if the source type is DocumentDB."},
+			"mongodb_enable_client_side_full_document_lookup": schema.BoolAttribute{Optional: true, Computed: true, PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()}, MarkdownDescription: "If set to true, Artie will look up the full document for update events itself instead of asking the change stream to do it. This can reduce load on busy clusters. This is only applicable if the source type is MongoDB or DocumentDB."},
+			"enable_unify_across_databases":                   schema.BoolAttribute{Optional: true, Computed: true, PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()}, MarkdownDescription: "If set to true, you can specify multiple databases within your Microsoft SQL Server that we should sync data from, and we will unify tables with the same name and schema into a single destination table. This is useful if you have multiple identical databases and want to fan-in the data. This is only applicable if the source type is Microsoft SQL Server and `mssql_replication_method` is set to `fn_dblog` or `change_tracking`."},
+			"databases_to_unify":                              schema.ListAttribute{Optional: true, Computed: true, ElementType: types.StringType, PlanModifiers: []planmodifier.List{listplanmodifier.UseStateForUnknown()}, MarkdownDescription: "If `enable_unify_across_databases` is set to true, this should be a list of databases within your Microsoft SQL Server that we should sync data from. All tables that you opt into being unified should exist in each of these databases. This is only applicable if the source type is Microsoft SQL Server."},
 			"status_override": schema.StringAttribute{
 				Optional:            true,
 				MarkdownDescription: "Only available if the source reader has `is_shared` set to true. This setting overrides the source reader status after update. Currently only `paused` is supported. If set to `paused`, a shared source reader will be paused instead of deployed after an update. This cannot be set on creation.",
@@ -114,12 +122,19 @@ func (r *SourceReaderResource) Configure(ctx context.Context, req resource.Confi
 		return
 	}
 
+	client, err := providerData.NewClient()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to build Artie client", err.Error())
+		return
+	}
+
 	openAPIClient, err := providerData.NewOpenAPIClient()
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to build Artie client", err.Error())
 		return
 	}
 
+	r.client = client
 	r.sourceReaders = artieclient.NewSourceReaderClient(openAPIClient)
 }
 
@@ -199,6 +214,69 @@ func validateSourceReaderConfig(ctx context.Context, configData tfmodels.SourceR
 	return diags
 }
 
+// validateSourceReaderSettingsForConnector checks that configData only sets the settings that apply to a source connector
+// of connectorType. This can't be done in ValidateConfig because the connector's type isn't part of the config, so it's
+// done in ModifyPlan once the connector is known, and again when the source reader is applied.
+func validateSourceReaderSettingsForConnector(connectorType artieclient.ConnectorType, configData tfmodels.SourceReader) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	isMongoCompatible := connectorType == connectors.MongoDB || connectorType == connectors.DocumentDB
+	mongoSettings := map[string]types.Bool{
+		"mongodb_disable_full_document_before_change":     configData.MongoDBDisablePreImages,
+		"mongodb_enable_client_side_full_document_lookup": configData.MongoDBClientSideDocumentLookup,
+	}
+	for _, name := range slices.Sorted(maps.Keys(mongoSettings)) {
+		if !isMongoCompatible && tfmodels.IsKnown(mongoSettings[name]) {
+			diags.AddAttributeError(path.Root(name), "Invalid configuration", fmt.Sprintf("`%s` is only applicable if the source type is MongoDB or DocumentDB.", name))
+		}
+	}
+
+	if connectorType == connectors.DocumentDB && tfmodels.IsExplicitlyFalse(configData.MongoDBDisablePreImages) {
+		diags.AddAttributeError(path.Root("mongodb_disable_full_document_before_change"), "Invalid configuration", "DocumentDB doesn't support change stream pre-images, so `mongodb_disable_full_document_before_change` cannot be set to false.")
+	}
+
+	if connectorType == connectors.PlanetScale && tfmodels.IsKnownAndEmpty(configData.DatabaseName) {
+		diags.AddAttributeError(path.Root("database_name"), "Invalid configuration", "`database_name` is required if the source type is PlanetScale, since it's the Vitess keyspace to read from.")
+	}
+
+	return diags
+}
+
+// sourceReaderForConnector returns planData with the settings that don't apply to a source connector of connectorType
+// cleared, so that they aren't sent to the API. DocumentDB doesn't support change stream pre-images, so they're
+// disabled for it unless the config says otherwise.
+func sourceReaderForConnector(connectorType artieclient.ConnectorType, planData tfmodels.SourceReader) tfmodels.SourceReader {
+	switch connectorType {
+	case connectors.MongoDB:
+	case connectors.DocumentDB:
+		if !tfmodels.IsKnown(planData.MongoDBDisablePreImages) {
+			planData.MongoDBDisablePreImages = types.BoolValue(true)
+		}
+	default:
+		planData.MongoDBDisablePreImages = types.BoolNull()
+		planData.MongoDBClientSideDocumentLookup = types.BoolNull()
+	}
+	return planData
+}
+
+// validateSettingsForConnector looks up the source reader's connector, checks that configData is valid for it and
+// returns its type.
+func (r *SourceReaderResource) validateSettingsForConnector(ctx context.Context, config tfsdk.Config) (artieclient.ConnectorType, diag.Diagnostics) {
+	var configData tfmodels.SourceReader
+	diags := config.Get(ctx, &configData)
+	if diags.HasError() {
+		return "", diags
+	}
+
+	connector, err := r.client.Connectors().Get(ctx, configData.ConnectorUUID.ValueString())
+	if err != nil {
+		diags.AddError("Unable to Read source Connector", err.Error())
+		return "", diags
+	}
+	diags.Append(validateSourceReaderSettingsForConnector(connector.Type, configData)...)
+	return connector.Type, diags
+}
+
 func (r *SourceReaderResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
 	var configData tfmodels.SourceReader
 	resp.Diagnostics.Append(req.Config.Get(ctx, &configData)...)
@@ -209,6 +287,23 @@ func (r *SourceReaderResource) ValidateConfig(ctx context.Context, req resource.
 	resp.Diagnostics.Append(validateSourceReaderConfig(ctx, configData)...)
 }
 
+func (r *SourceReaderResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to validate if the source reader is being destroyed or hasn't changed.
+	if req.Plan.Raw.IsNull() || req.Plan.Raw.Equal(req.State.Raw) {
+		return
+	}
+
+	// The connector might not have been created yet, in which case its settings are only checked on apply.
+	var connectorUUID types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("connector_uuid"), &connectorUUID)...)
+	if resp.Diagnostics.HasError() || !tfmodels.IsKnown(connectorUUID) {
+		return
+	}
+
+	_, diags := r.validateSettingsForConnector(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+}
+
 func (r *SourceReaderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	planData, hasError := r.GetPlanData(ctx, req.Plan, &resp.Diagnostics)
 	if hasError {
@@ -220,6 +315,13 @@ func (r *SourceReaderResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
+	connectorType, diags := r.validateSettingsForConnector(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	planData = sourceReaderForConnector(connectorType, planData)
+
 	apiModel, diags := planData.ToAPIPayload(ctx)
 	resp.Diagnostics.Append(diags...)
 	if diags.HasError() {
@@ -256,6 +358,11 @@ func (r *SourceReaderResource) Read(ctx context.Context, req resource.ReadReques
 
 	sourceReader, err := r.sourceReaders.Get(ctx, stateData.UUID.ValueString())
 	if err != nil {
+		if errors.As(err, &artieclient.NotFoundError{}) {
+			tflog.Warn(ctx, fmt.Sprintf("Source Reader %s no longer exists, removing it from state", stateData.UUID.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError("Unable to read Source Reader", err.Error())
 		return
 	}
@@ -269,6 +376,13 @@ func (r *SourceReaderResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
+	connectorType, diags := r.validateSettingsForConnector(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	planData = sourceReaderForConnector(connectorType, planData)
+
 	apiModel, diags := planData.ToAPIModel(ctx)
 	resp.Diagnostics.Append(diags...)
 	if diags.HasError() {
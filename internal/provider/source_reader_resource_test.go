@@ -1,13 +1,24 @@
 package provider
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	tfresource "github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
+	"terraform-provider-artie/internal/artieclient"
+	"terraform-provider-artie/internal/lib"
+	"terraform-provider-artie/internal/openapi"
+	"terraform-provider-artie/internal/provider/connectors"
 	"terraform-provider-artie/internal/provider/tfmodels"
 )
 
@@ -201,3 +212,243 @@ func TestSourceReaderResource_ValidateConfig(t *testing.T) {
 		assert.False(t, diags.HasError())
 	}
 }
+
+func TestValidateSourceReaderSettingsForConnector(t *testing.T) {
+	{
+		// Settings that aren't set are never rejected.
+		for _, connectorType := range []artieclient.ConnectorType{connectors.MongoDB, connectors.DocumentDB, connectors.PostgreSQL} {
+			diags := validateSourceReaderSettingsForConnector(connectorType, tfmodels.SourceReader{})
+			assert.False(t, diags.HasError())
+		}
+	}
+	{
+		config := tfmodels.SourceReader{
+			MongoDBDisablePreImages:         types.BoolValue(false),
+			MongoDBClientSideDocumentLookup: types.BoolValue(true),
+		}
+		diags := validateSourceReaderSettingsForConnector(connectors.MongoDB, config)
+		assert.False(t, diags.HasError())
+	}
+	{
+		config := tfmodels.SourceReader{
+			MongoDBDisablePreImages:         types.BoolValue(true),
+			MongoDBClientSideDocumentLookup: types.BoolValue(true),
+		}
+		diags := validateSourceReaderSettingsForConnector(connectors.DocumentDB, config)
+		assert.False(t, diags.HasError())
+	}
+	{
+		// PlanetScale sources read from a single Vitess keyspace.
+		diags := validateSourceReaderSettingsForConnector(connectors.PlanetScale, tfmodels.SourceReader{DatabaseName: types.StringValue("")})
+		require.Len(t, diags.Errors(), 1)
+		assert.Contains(t, diags.Errors()[0].Detail(), "`database_name` is required if the source type is PlanetScale")
+
+		diags = validateSourceReaderSettingsForConnector(connectors.PlanetScale, tfmodels.SourceReader{DatabaseName: types.StringValue("orders")})
+		assert.False(t, diags.HasError(), diags)
+	}
+	{
+		// DocumentDB doesn't support change stream pre-images.
+		config := tfmodels.SourceReader{MongoDBDisablePreImages: types.BoolValue(false)}
+		diags := validateSourceReaderSettingsForConnector(connectors.DocumentDB, config)
+		require.Len(t, diags.Errors(), 1)
+		assert.Contains(t, diags.Errors()[0].Detail(), "DocumentDB doesn't support change stream pre-images")
+	}
+	{
+		config := tfmodels.SourceReader{
+			MongoDBDisablePreImages:         types.BoolValue(true),
+			MongoDBClientSideDocumentLookup: types.BoolValue(false),
+		}
+		diags := validateSourceReaderSettingsForConnector(connectors.PostgreSQL, config)
+		require.Len(t, diags.Errors(), 2)
+		assert.Contains(t, diags.Errors()[0].Detail(), "`mongodb_disable_full_document_before_change` is only applicable if the source type is MongoDB or DocumentDB.")
+		assert.Contains(t, diags.Errors()[1].Detail(), "`mongodb_enable_client_side_full_document_lookup` is only applicable if the source type is MongoDB or DocumentDB.")
+	}
+}
+
+func TestSourceReaderForConnector(t *testing.T) {
+	ctx := t.Context()
+	// settings returns the settings that are sent to the API for a source reader with the given plan.
+	settings := func(connectorType artieclient.ConnectorType, planData tfmodels.SourceReader) openapi.PayloadsSourceReaderSettingsPayload {
+		planData.ConnectorUUID = types.StringValue(uuid.NewString())
+		payload, diags := sourceReaderForConnector(connectorType, planData).ToAPIPayload(ctx)
+		require.False(t, diags.HasError(), diags)
+		return payload.Settings
+	}
+	// When the Mongo settings aren't in the config, they're unknown in the plan for a new source reader.
+	notSet := tfmodels.SourceReader{
+		MongoDBDisablePreImages:         types.BoolUnknown(),
+		MongoDBClientSideDocumentLookup: types.BoolUnknown(),
+	}
+	{
+		// DocumentDB doesn't support pre-images, so they're disabled unless the config says otherwise.
+		apiSettings := settings(connectors.DocumentDB, notSet)
+		assert.Equal(t, lib.ToPtr(true), apiSettings.DisableFullDocumentBeforeChange)
+		assert.Nil(t, apiSettings.EnableClientSideFullDocumentLookup)
+	}
+	{
+		apiSettings := settings(connectors.MongoDB, notSet)
+		assert.Nil(t, apiSettings.DisableFullDocumentBeforeChange)
+		assert.Nil(t, apiSettings.EnableClientSideFullDocumentLookup)
+	}
+	{
+		planData := tfmodels.SourceReader{MongoDBDisablePreImages: types.BoolValue(false), MongoDBClientSideDocumentLookup: types.BoolValue(true)}
+		apiSettings := settings(connectors.MongoDB, planData)
+		assert.Equal(t, lib.ToPtr(false), apiSettings.DisableFullDocumentBeforeChange)
+		assert.Equal(t, lib.ToPtr(true), apiSettings.EnableClientSideFullDocumentLookup)
+	}
+	{
+		// The Mongo settings are never sent for other sources, even if the API returned them before.
+		planData := tfmodels.SourceReader{MongoDBDisablePreImages: types.BoolValue(false), MongoDBClientSideDocumentLookup: types.BoolValue(false)}
+		apiSettings := settings(connectors.PostgreSQL, planData)
+		assert.Nil(t, apiSettings.DisableFullDocumentBeforeChange)
+		assert.Nil(t, apiSettings.EnableClientSideFullDocumentLookup)
+	}
+}
+
+func TestSourceReaderResource_ModifyPlan(t *testing.T) {
+	ctx := t.Context()
+	server := newTestAccServer(t)
+	recorder := newRequestRecorder(t, server.URL)
+	r := &SourceReaderResource{}
+	configureTestResource(t, r, recorder.URL)
+
+	documentDB, err := r.client.Connectors().Create(ctx, artieclient.BaseConnector{Type: connectors.DocumentDB, Label: "documentdb"})
+	require.NoError(t, err)
+	sourceReader, err := r.sourceReaders.Create(ctx, openapi.RouterSourceReaderCreateRequest{ConnectorUUID: documentDB.UUID, Name: lib.ToPtr("reader")})
+	require.NoError(t, err)
+	state := newTestState(t, ctx, r, sourceReader.Uuid.String())
+	readResp := resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, &readResp)
+	require.False(t, readResp.Diagnostics.HasError(), readResp.Diagnostics)
+	state = readResp.State
+
+	// modifyPlan plans a change to the source reader's settings and returns the diagnostics and the number of requests
+	// that were sent to the API.
+	modifyPlan := func(attributes map[string]attr.Value) (diag.Diagnostics, int) {
+		plan := tfsdk.Plan{Schema: state.Schema, Raw: state.Raw.Copy()}
+		for name, value := range attributes {
+			diags := plan.SetAttribute(ctx, path.Root(name), value)
+			require.False(t, diags.HasError(), diags)
+		}
+		requestCount := len(recorder.Requests())
+		resp := resource.ModifyPlanResponse{Plan: plan}
+		r.ModifyPlan(ctx, resource.ModifyPlanRequest{State: state, Plan: plan, Config: tfsdk.Config(plan)}, &resp)
+		return resp.Diagnostics, len(recorder.Requests()) - requestCount
+	}
+	{
+		diags, requestCount := modifyPlan(nil)
+		assert.False(t, diags.HasError(), diags)
+		assert.Zero(t, requestCount)
+	}
+	{
+		diags, _ := modifyPlan(map[string]attr.Value{"mongodb_disable_full_document_before_change": types.BoolValue(true)})
+		assert.False(t, diags.HasError(), diags)
+	}
+	{
+		// DocumentDB doesn't support change stream pre-images, which is caught before anything is applied.
+		diags, _ := modifyPlan(map[string]attr.Value{"mongodb_disable_full_document_before_change": types.BoolValue(false)})
+		require.True(t, diags.HasError())
+		assert.Contains(t, diags.Errors()[0].Detail(), "DocumentDB doesn't support change stream pre-images")
+	}
+	{
+		// The connector's type can't be looked up if it hasn't been created yet.
+		diags, requestCount := modifyPlan(map[string]attr.Value{
+			"connector_uuid": types.StringUnknown(),
+			"mongodb_disable_full_document_before_change": types.BoolValue(false),
+		})
+		assert.False(t, diags.HasError(), diags)
+		assert.Zero(t, requestCount)
+	}
+}
+
+func TestSourceReaderResource_ReadNotFound(t *testing.T) {
+	testReadRemovesMissingResource(t, NewSourceReaderResource)
+}
+
+func testAccSourceReaderConfig(name string) string {
+	return fmt.Sprintf(`
+resource "artie_connector" "postgres" {
+  name = "Postgres"
+  type = "postgresql"
+  postgresql_config = {
+    host     = "db.example.com"
+    port     = 5432
+    username = "artie"
+    password = "hunter2"
+  }
+}
+
+resource "artie_source_reader" "test" {
+  name           = %q
+  connector_uuid = artie_connector.postgres.uuid
+  database_name  = "customers"
+}
+`, name)
+}
+
+func TestAccSourceReaderResource(t *testing.T) {
+	server := newTestAccServer(t)
+	tfresource.Test(t, tfresource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(server),
+		Steps: []tfresource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + testAccSourceReaderConfig("Reader"),
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckResourceAttr("artie_source_reader.test", "name", "Reader"),
+					tfresource.TestCheckResourceAttr("artie_source_reader.test", "database_name", "customers"),
+					tfresource.TestCheckResourceAttr("artie_source_reader.test", "is_shared", "false"),
+					tfresource.TestCheckResourceAttrPair("artie_source_reader.test", "connector_uuid", "artie_connector.postgres", "uuid"),
+					tfresource.TestCheckResourceAttrSet("artie_source_reader.test", "uuid"),
+				),
+			},
+			{
+				Config: testAccProviderConfig(server) + testAccSourceReaderConfig("Renamed Reader"),
+				Check:  tfresource.TestCheckResourceAttr("artie_source_reader.test", "name", "Renamed Reader"),
+			},
+			{
+				ResourceName:                         "artie_source_reader.test",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "uuid",
+				ImportStateIdFunc:                    testAccImportStateUUID("artie_source_reader.test"),
+			},
+		},
+	})
+}
+
+func TestAccSourceReaderResource_DocumentDB(t *testing.T) {
+	server := newTestAccServer(t)
+	tfresource.Test(t, tfresource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(server),
+		Steps: []tfresource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + `
+resource "artie_connector" "documentdb" {
+  name = "DocumentDB"
+  type = "documentdb"
+  documentdb_config = {
+    host     = "catalog.cluster-abc123.us-east-1.docdb.amazonaws.com"
+    username = "artie"
+    password = "hunter2"
+  }
+}
+
+resource "artie_source_reader" "test" {
+  name                                            = "DocumentDB"
+  connector_uuid                                  = artie_connector.documentdb.uuid
+  database_name                                   = "catalog"
+  mongodb_disable_full_document_before_change     = true
+  mongodb_enable_client_side_full_document_lookup = true
+}
+`,
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckResourceAttr("artie_connector.documentdb", "documentdb_config.port", "27017"),
+					tfresource.TestCheckResourceAttr("artie_connector.documentdb", "documentdb_config.tls_enabled", "true"),
+					tfresource.TestCheckResourceAttr("artie_source_reader.test", "mongodb_disable_full_document_before_change", "true"),
+				),
+			},
+		},
+	})
+}
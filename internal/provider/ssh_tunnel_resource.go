@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math"
 	"terraform-provider-artie/internal/artieclient"
@@ -16,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -125,6 +127,11 @@ func (r *SSHTunnelResource) Read(ctx context.Context, req resource.ReadRequest,
 
 	sshTunnel, err := r.client.SSHTunnels().Get(ctx, tunnelUUID)
 	if err != nil {
+		if errors.As(err, &artieclient.NotFoundError{}) {
+			tflog.Warn(ctx, fmt.Sprintf("SSH Tunnel %s no longer exists, removing it from state", tunnelUUID))
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError("Unable to Read SSH Tunnel", err.Error())
 		return
 	}
@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestSSHTunnelResource_ReadNotFound(t *testing.T) {
+	testReadRemovesMissingResource(t, NewSSHTunnelResource)
+}
+
+func testAccSSHTunnelConfig(name string) string {
+	return fmt.Sprintf(`
+resource "artie_ssh_tunnel" "test" {
+  name     = %q
+  host     = "1.2.3.4"
+  port     = 22
+  username = "artie"
+}
+`, name)
+}
+
+func TestAccSSHTunnelResource(t *testing.T) {
+	server := newTestAccServer(t)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(server),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + testAccSSHTunnelConfig("Tunnel"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("artie_ssh_tunnel.test", "name", "Tunnel"),
+					resource.TestCheckResourceAttr("artie_ssh_tunnel.test", "port", "22"),
+					resource.TestCheckResourceAttrSet("artie_ssh_tunnel.test", "uuid"),
+					resource.TestCheckResourceAttrSet("artie_ssh_tunnel.test", "public_key"),
+				),
+			},
+			{
+				Config: testAccProviderConfig(server) + testAccSSHTunnelConfig("Renamed Tunnel"),
+				Check:  resource.TestCheckResourceAttr("artie_ssh_tunnel.test", "name", "Renamed Tunnel"),
+			},
+			{
+				ResourceName:                         "artie_ssh_tunnel.test",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "uuid",
+				ImportStateIdFunc:                    testAccImportStateUUID("artie_ssh_tunnel.test"),
+			},
+		},
+	})
+}
@@ -1,601 +0,0 @@
-package tfmodels
-
-import (
-	"fmt"
-
-	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/types"
-
-	"terraform-provider-artie/internal/artieclient"
-)
-
-type Connector struct {
-	UUID              types.String             `tfsdk:"uuid"`
-	SSHTunnelUUID     types.String             `tfsdk:"ssh_tunnel_uuid"`
-	Type              types.String             `tfsdk:"type"`
-	Name              types.String             `tfsdk:"name"`
-	DataPlaneName     types.String             `tfsdk:"data_plane_name"`
-	BigQueryConfig    *BigQuerySharedConfig    `tfsdk:"bigquery_config"`
-	CockroachDBConfig *CockroachDBSharedConfig `tfsdk:"cockroach_config"`
-	DynamoDBConfig    *DynamoDBConfig          `tfsdk:"dynamodb_config"`
-	GCSConfig         *GCSSharedConfig         `tfsdk:"gcs_config"`
-	IcebergConfig     *IcebergSharedConfig     `tfsdk:"iceberg_config"`
-	MongoDBConfig     *MongoDBSharedConfig     `tfsdk:"mongodb_config"`
-	MySQLConfig       *MySQLSharedConfig       `tfsdk:"mysql_config"`
-	MSSQLConfig       *MSSQLSharedConfig       `tfsdk:"mssql_config"`
-	OracleConfig      *OracleSharedConfig      `tfsdk:"oracle_config"`
-	PostgresConfig    *PostgresSharedConfig    `tfsdk:"postgresql_config"`
-	RedshiftConfig    *RedshiftSharedConfig    `tfsdk:"redshift_config"`
-	S3Config          *S3SharedConfig          `tfsdk:"s3_config"`
-	SnowflakeConfig   *SnowflakeSharedConfig   `tfsdk:"snowflake_config"`
-	DatabricksConfig  *DatabricksSharedConfig  `tfsdk:"databricks_config"`
-	KeyspacesConfig   *KeyspacesSharedConfig   `tfsdk:"keyspaces_config"`
-}
-
-func (c Connector) ToAPIBaseModel() (artieclient.BaseConnector, diag.Diagnostics) {
-	var sharedConfig artieclient.ConnectorConfig
-	connectorType, err := artieclient.ConnectorTypeFromString(c.Type.ValueString())
-	if err != nil {
-		return artieclient.BaseConnector{}, []diag.Diagnostic{diag.NewErrorDiagnostic(
-			"Unable to convert Connector to API model", err.Error(),
-		)}
-	}
-
-	switch connectorType {
-	case artieclient.API:
-		// No config needed
-	case artieclient.BigQuery:
-		sharedConfig = c.BigQueryConfig.ToAPIModel()
-	case artieclient.CockroachDB:
-		sharedConfig = c.CockroachDBConfig.ToAPIModel()
-	case artieclient.DynamoDB:
-		sharedConfig = c.DynamoDBConfig.ToAPIModel()
-	case artieclient.GCS:
-		sharedConfig = c.GCSConfig.ToAPIModel()
-	case artieclient.Iceberg:
-		sharedConfig = c.IcebergConfig.ToAPIModel()
-	case artieclient.MongoDB:
-		sharedConfig = c.MongoDBConfig.ToAPIModel()
-	case artieclient.MySQL:
-		sharedConfig = c.MySQLConfig.ToAPIModel()
-	case artieclient.MSSQL:
-		sharedConfig = c.MSSQLConfig.ToAPIModel()
-	case artieclient.Oracle:
-		sharedConfig = c.OracleConfig.ToAPIModel()
-	case artieclient.PostgreSQL:
-		sharedConfig = c.PostgresConfig.ToAPIModel()
-	case artieclient.Redshift:
-		sharedConfig = c.RedshiftConfig.ToAPIModel()
-	case artieclient.S3:
-		sharedConfig = c.S3Config.ToAPIModel()
-	case artieclient.Snowflake:
-		sharedConfig = c.SnowflakeConfig.ToAPIModel()
-	case artieclient.Databricks:
-		sharedConfig = c.DatabricksConfig.ToAPIModel()
-	case artieclient.Keyspaces:
-		sharedConfig = c.KeyspacesConfig.ToAPIModel()
-	default:
-		return artieclient.BaseConnector{}, []diag.Diagnostic{diag.NewErrorDiagnostic(
-			"Unable to convert Connector to API model", fmt.Sprintf("unhandled connector type: %s", c.Type.ValueString()),
-		)}
-	}
-
-	sshTunnelUUID, diags := parseOptionalUUID(c.SSHTunnelUUID)
-	if diags.HasError() {
-		return artieclient.BaseConnector{}, diags
-	}
-
-	return artieclient.BaseConnector{
-		Type:          connectorType,
-		DataPlaneName: c.DataPlaneName.ValueString(),
-		Label:         c.Name.ValueString(),
-		Config:        sharedConfig,
-		SSHTunnelUUID: sshTunnelUUID,
-	}, diags
-}
-
-func (c Connector) ToAPIModel() (artieclient.Connector, diag.Diagnostics) {
-	baseModel, diags := c.ToAPIBaseModel()
-	if diags.HasError() {
-		return artieclient.Connector{}, diags
-	}
-
-	uuid, uuidDiags := parseUUID(c.UUID)
-	diags.Append(uuidDiags...)
-	if diags.HasError() {
-		return artieclient.Connector{}, diags
-	}
-
-	return artieclient.Connector{
-		UUID:          uuid,
-		BaseConnector: baseModel,
-	}, diags
-}
-
-func ConnectorFromAPIModel(apiModel artieclient.Connector) (Connector, diag.Diagnostics) {
-	connector := Connector{
-		UUID:          types.StringValue(apiModel.UUID.String()),
-		Type:          types.StringValue(string(apiModel.Type)),
-		DataPlaneName: types.StringValue(apiModel.DataPlaneName),
-		Name:          types.StringValue(apiModel.Label),
-		SSHTunnelUUID: optionalUUIDToStringValue(apiModel.SSHTunnelUUID),
-	}
-
-	switch apiModel.Type {
-	case artieclient.API:
-		// No config needed
-	case artieclient.BigQuery:
-		connector.BigQueryConfig = BigQuerySharedConfigFromAPIModel(apiModel.Config)
-	case artieclient.CockroachDB:
-		connector.CockroachDBConfig = CockroachDBSharedConfigFromAPIModel(apiModel.Config)
-	case artieclient.DynamoDB:
-		connector.DynamoDBConfig = DynamoDBConfigFromAPIModel(apiModel.Config)
-	case artieclient.GCS:
-		connector.GCSConfig = GCSSharedConfigFromAPIModel(apiModel.Config)
-	case artieclient.Iceberg:
-		connector.IcebergConfig = IcebergSharedConfigFromAPIModel(apiModel.Config)
-	case artieclient.MongoDB:
-		connector.MongoDBConfig = MongoDBSharedConfigFromAPIModel(apiModel.Config)
-	case artieclient.MySQL:
-		connector.MySQLConfig = MySQLSharedConfigFromAPIModel(apiModel.Config)
-	case artieclient.MSSQL:
-		connector.MSSQLConfig = MSSQLSharedConfigFromAPIModel(apiModel.Config)
-	case artieclient.Oracle:
-		connector.OracleConfig = OracleSharedConfigFromAPIModel(apiModel.Config)
-	case artieclient.PostgreSQL:
-		connector.PostgresConfig = PostgresSharedConfigFromAPIModel(apiModel.Config)
-	case artieclient.Redshift:
-		connector.RedshiftConfig = RedshiftSharedConfigFromAPIModel(apiModel.Config)
-	case artieclient.S3:
-		connector.S3Config = S3SharedConfigFromAPIModel(apiModel.Config)
-	case artieclient.Snowflake:
-		connector.SnowflakeConfig = SnowflakeSharedConfigFromAPIModel(apiModel.Config)
-	case artieclient.Databricks:
-		connector.DatabricksConfig = DatabricksSharedConfigFromAPIModel(apiModel.Config)
-	case artieclient.Keyspaces:
-		connector.KeyspacesConfig = KeyspacesSharedConfigFromAPIModel(apiModel.Config)
-	default:
-		return Connector{}, []diag.Diagnostic{diag.NewErrorDiagnostic(
-			"Unable to convert API model to Connector", fmt.Sprintf("invalid connector type: %s", apiModel.Type),
-		)}
-	}
-
-	return connector, nil
-}
-
-type BigQuerySharedConfig struct {
-	ProjectID       types.String `tfsdk:"project_id"`
-	Location        types.String `tfsdk:"location"`
-	CredentialsData types.String `tfsdk:"credentials_data"`
-}
-
-func (b BigQuerySharedConfig) ToAPIModel() artieclient.ConnectorConfig {
-	return artieclient.ConnectorConfig{
-		GCPProjectID:       b.ProjectID.ValueString(),
-		GCPLocation:        b.Location.ValueString(),
-		GCPCredentialsData: b.CredentialsData.ValueString(),
-	}
-}
-
-func BigQuerySharedConfigFromAPIModel(apiModel artieclient.ConnectorConfig) *BigQuerySharedConfig {
-	return &BigQuerySharedConfig{
-		ProjectID:       types.StringValue(apiModel.GCPProjectID),
-		Location:        types.StringValue(apiModel.GCPLocation),
-		CredentialsData: types.StringValue(apiModel.GCPCredentialsData),
-	}
-}
-
-type DynamoDBConfig struct {
-	StreamArn          types.String `tfsdk:"stream_arn"`
-	AwsAccessKeyID     types.String `tfsdk:"access_key_id"`
-	AwsSecretAccessKey types.String `tfsdk:"secret_access_key"`
-	RoleARN            types.String `tfsdk:"role_arn"`
-	ExternalID         types.String `tfsdk:"external_id"`
-}
-
-func (d DynamoDBConfig) ToAPIModel() artieclient.ConnectorConfig {
-	return artieclient.ConnectorConfig{
-		DynamoStreamArn:    d.StreamArn.ValueString(),
-		AWSAccessKeyID:     d.AwsAccessKeyID.ValueString(),
-		AWSSecretAccessKey: d.AwsSecretAccessKey.ValueString(),
-		AWSRoleARN:         d.RoleARN.ValueString(),
-		AWSExternalID:      d.ExternalID.ValueString(),
-	}
-}
-
-func DynamoDBConfigFromAPIModel(apiDynamoCfg artieclient.ConnectorConfig) *DynamoDBConfig {
-	return &DynamoDBConfig{
-		StreamArn:          types.StringValue(apiDynamoCfg.DynamoStreamArn),
-		AwsAccessKeyID:     types.StringValue(apiDynamoCfg.AWSAccessKeyID),
-		AwsSecretAccessKey: types.StringValue(apiDynamoCfg.AWSSecretAccessKey),
-		RoleARN:            types.StringValue(apiDynamoCfg.AWSRoleARN),
-		ExternalID:         types.StringValue(apiDynamoCfg.AWSExternalID),
-	}
-}
-
-type CockroachDBSharedConfig struct {
-	Host         types.String `tfsdk:"host"`
-	SnapshotHost types.String `tfsdk:"snapshot_host"`
-	SnapshotPort types.Int32  `tfsdk:"snapshot_port"`
-	Port         types.Int32  `tfsdk:"port"`
-	Username     types.String `tfsdk:"username"`
-	Password     types.String `tfsdk:"password"`
-}
-
-func (c CockroachDBSharedConfig) ToAPIModel() artieclient.ConnectorConfig {
-	return artieclient.ConnectorConfig{
-		Host:         c.Host.ValueString(),
-		SnapshotHost: c.SnapshotHost.ValueString(),
-		SnapshotPort: c.SnapshotPort.ValueInt32(),
-		Port:         c.Port.ValueInt32(),
-		User:         c.Username.ValueString(),
-		Password:     c.Password.ValueString(),
-	}
-}
-
-func CockroachDBSharedConfigFromAPIModel(apiModel artieclient.ConnectorConfig) *CockroachDBSharedConfig {
-	return &CockroachDBSharedConfig{
-		Host:         types.StringValue(apiModel.Host),
-		SnapshotHost: types.StringValue(apiModel.SnapshotHost),
-		SnapshotPort: types.Int32Value(apiModel.SnapshotPort),
-		Port:         types.Int32Value(apiModel.Port),
-		Username:     types.StringValue(apiModel.User),
-		Password:     types.StringValue(apiModel.Password),
-	}
-}
-
-type MongoDBSharedConfig struct {
-	Host     types.String `tfsdk:"host"`
-	Username types.String `tfsdk:"username"`
-	Password types.String `tfsdk:"password"`
-}
-
-func (m MongoDBSharedConfig) ToAPIModel() artieclient.ConnectorConfig {
-	return artieclient.ConnectorConfig{
-		Host:     m.Host.ValueString(),
-		User:     m.Username.ValueString(),
-		Password: m.Password.ValueString(),
-	}
-}
-
-func MongoDBSharedConfigFromAPIModel(apiModel artieclient.ConnectorConfig) *MongoDBSharedConfig {
-	return &MongoDBSharedConfig{
-		Host:     types.StringValue(apiModel.Host),
-		Username: types.StringValue(apiModel.User),
-		Password: types.StringValue(apiModel.Password),
-	}
-}
-
-type MySQLSharedConfig struct {
-	Host         types.String `tfsdk:"host"`
-	SnapshotHost types.String `tfsdk:"snapshot_host"`
-	SnapshotPort types.Int32  `tfsdk:"snapshot_port"`
-	Port         types.Int32  `tfsdk:"port"`
-	Username     types.String `tfsdk:"username"`
-	Password     types.String `tfsdk:"password"`
-	TLSMode      types.String `tfsdk:"tls_mode"`
-}
-
-func (m MySQLSharedConfig) ToAPIModel() artieclient.ConnectorConfig {
-	return artieclient.ConnectorConfig{
-		Host:         m.Host.ValueString(),
-		SnapshotHost: m.SnapshotHost.ValueString(),
-		SnapshotPort: m.SnapshotPort.ValueInt32(),
-		Port:         m.Port.ValueInt32(),
-		User:         m.Username.ValueString(),
-		Password:     m.Password.ValueString(),
-		MySQLTLSMode: m.TLSMode.ValueString(),
-	}
-}
-
-func MySQLSharedConfigFromAPIModel(apiModel artieclient.ConnectorConfig) *MySQLSharedConfig {
-	return &MySQLSharedConfig{
-		Host:         types.StringValue(apiModel.Host),
-		SnapshotHost: types.StringValue(apiModel.SnapshotHost),
-		SnapshotPort: types.Int32Value(apiModel.SnapshotPort),
-		Port:         types.Int32Value(apiModel.Port),
-		Username:     types.StringValue(apiModel.User),
-		Password:     types.StringValue(apiModel.Password),
-		TLSMode:      types.StringValue(apiModel.MySQLTLSMode),
-	}
-}
-
-type MSSQLSharedConfig struct {
-	Host         types.String `tfsdk:"host"`
-	SnapshotHost types.String `tfsdk:"snapshot_host"`
-	Port         types.Int32  `tfsdk:"port"`
-	Username     types.String `tfsdk:"username"`
-	Password     types.String `tfsdk:"password"`
-}
-
-func (r MSSQLSharedConfig) ToAPIModel() artieclient.ConnectorConfig {
-	return artieclient.ConnectorConfig{
-		Host:         r.Host.ValueString(),
-		SnapshotHost: r.SnapshotHost.ValueString(),
-		Port:         r.Port.ValueInt32(),
-		Username:     r.Username.ValueString(),
-		Password:     r.Password.ValueString(),
-	}
-}
-
-func MSSQLSharedConfigFromAPIModel(apiModel artieclient.ConnectorConfig) *MSSQLSharedConfig {
-	return &MSSQLSharedConfig{
-		Host:         types.StringValue(apiModel.Host),
-		SnapshotHost: types.StringValue(apiModel.SnapshotHost),
-		Port:         types.Int32Value(apiModel.Port),
-		Username:     types.StringValue(apiModel.Username),
-		Password:     types.StringValue(apiModel.Password),
-	}
-}
-
-type OracleSharedConfig struct {
-	Host         types.String `tfsdk:"host"`
-	SnapshotHost types.String `tfsdk:"snapshot_host"`
-	Port         types.Int32  `tfsdk:"port"`
-	Username     types.String `tfsdk:"username"`
-	Password     types.String `tfsdk:"password"`
-}
-
-func (o OracleSharedConfig) ToAPIModel() artieclient.ConnectorConfig {
-	return artieclient.ConnectorConfig{
-		Host:         o.Host.ValueString(),
-		SnapshotHost: o.SnapshotHost.ValueString(),
-		Port:         o.Port.ValueInt32(),
-		User:         o.Username.ValueString(),
-		Password:     o.Password.ValueString(),
-	}
-}
-
-func OracleSharedConfigFromAPIModel(apiModel artieclient.ConnectorConfig) *OracleSharedConfig {
-	return &OracleSharedConfig{
-		Host:     types.StringValue(apiModel.Host),
-		Port:     types.Int32Value(apiModel.Port),
-		Username: types.StringValue(apiModel.User),
-		Password: types.StringValue(apiModel.Password),
-	}
-}
-
-type PostgresSharedConfig struct {
-	Host         types.String `tfsdk:"host"`
-	SnapshotHost types.String `tfsdk:"snapshot_host"`
-	Port         types.Int32  `tfsdk:"port"`
-	Username     types.String `tfsdk:"username"`
-	Password     types.String `tfsdk:"password"`
-}
-
-func (p PostgresSharedConfig) ToAPIModel() artieclient.ConnectorConfig {
-	return artieclient.ConnectorConfig{
-		Host:         p.Host.ValueString(),
-		SnapshotHost: p.SnapshotHost.ValueString(),
-		Port:         p.Port.ValueInt32(),
-		User:         p.Username.ValueString(),
-		Password:     p.Password.ValueString(),
-	}
-}
-
-func PostgresSharedConfigFromAPIModel(apiModel artieclient.ConnectorConfig) *PostgresSharedConfig {
-	return &PostgresSharedConfig{
-		Host:     types.StringValue(apiModel.Host),
-		Port:     types.Int32Value(apiModel.Port),
-		Username: types.StringValue(apiModel.User),
-		Password: types.StringValue(apiModel.Password),
-	}
-}
-
-type RedshiftSharedConfig struct {
-	Endpoint types.String `tfsdk:"endpoint"`
-	Username types.String `tfsdk:"username"`
-	Password types.String `tfsdk:"password"`
-}
-
-func (r RedshiftSharedConfig) ToAPIModel() artieclient.ConnectorConfig {
-	return artieclient.ConnectorConfig{
-		Endpoint: r.Endpoint.ValueString(),
-		Username: r.Username.ValueString(),
-		Password: r.Password.ValueString(),
-	}
-}
-
-func RedshiftSharedConfigFromAPIModel(apiModel artieclient.ConnectorConfig) *RedshiftSharedConfig {
-	return &RedshiftSharedConfig{
-		Endpoint: types.StringValue(apiModel.Endpoint),
-		Username: types.StringValue(apiModel.Username),
-		Password: types.StringValue(apiModel.Password),
-	}
-}
-
-type S3SharedConfig struct {
-	AccessKeyID     types.String `tfsdk:"access_key_id"`
-	SecretAccessKey types.String `tfsdk:"secret_access_key"`
-	Region          types.String `tfsdk:"region"`
-	RoleARN         types.String `tfsdk:"role_arn"`
-	ExternalID      types.String `tfsdk:"external_id"`
-}
-
-func (s S3SharedConfig) ToAPIModel() artieclient.ConnectorConfig {
-	return artieclient.ConnectorConfig{
-		AWSAccessKeyID:     s.AccessKeyID.ValueString(),
-		AWSSecretAccessKey: s.SecretAccessKey.ValueString(),
-		AWSRegion:          s.Region.ValueString(),
-		AWSRoleARN:         s.RoleARN.ValueString(),
-		AWSExternalID:      s.ExternalID.ValueString(),
-	}
-}
-
-func S3SharedConfigFromAPIModel(apiModel artieclient.ConnectorConfig) *S3SharedConfig {
-	return &S3SharedConfig{
-		AccessKeyID:     types.StringValue(apiModel.AWSAccessKeyID),
-		SecretAccessKey: types.StringValue(apiModel.AWSSecretAccessKey),
-		Region:          types.StringValue(apiModel.AWSRegion),
-		RoleARN:         types.StringValue(apiModel.AWSRoleARN),
-		ExternalID:      types.StringValue(apiModel.AWSExternalID),
-	}
-}
-
-type SnowflakeSharedConfig struct {
-	AccountIdentifier types.String `tfsdk:"account_identifier"`
-	AccountURL        types.String `tfsdk:"account_url"`
-	VirtualDWH        types.String `tfsdk:"virtual_dwh"`
-	Username          types.String `tfsdk:"username"`
-	Password          types.String `tfsdk:"password"`
-	PrivateKey        types.String `tfsdk:"private_key"`
-}
-
-func (s SnowflakeSharedConfig) ToAPIModel() artieclient.ConnectorConfig {
-	return artieclient.ConnectorConfig{
-		SnowflakeAccountIdentifier: s.AccountIdentifier.ValueString(),
-		SnowflakeAccountURL:        s.AccountURL.ValueString(),
-		SnowflakeVirtualDWH:        s.VirtualDWH.ValueString(),
-		SnowflakePrivateKey:        s.PrivateKey.ValueString(),
-		Username:                   s.Username.ValueString(),
-		Password:                   s.Password.ValueString(),
-	}
-}
-
-func SnowflakeSharedConfigFromAPIModel(apiModel artieclient.ConnectorConfig) *SnowflakeSharedConfig {
-	return &SnowflakeSharedConfig{
-		AccountIdentifier: types.StringValue(apiModel.SnowflakeAccountIdentifier),
-		AccountURL:        types.StringValue(apiModel.SnowflakeAccountURL),
-		VirtualDWH:        types.StringValue(apiModel.SnowflakeVirtualDWH),
-		PrivateKey:        types.StringValue(apiModel.SnowflakePrivateKey),
-		Username:          types.StringValue(apiModel.Username),
-		Password:          types.StringValue(apiModel.Password),
-	}
-}
-
-type DatabricksSharedConfig struct {
-	Host                types.String `tfsdk:"host"`
-	HttpPath            types.String `tfsdk:"http_path"`
-	PersonalAccessToken types.String `tfsdk:"personal_access_token"`
-	ClientID            types.String `tfsdk:"client_id"`
-	ClientSecret        types.String `tfsdk:"client_secret"`
-	Volume              types.String `tfsdk:"volume"`
-}
-
-func (d DatabricksSharedConfig) ToAPIModel() artieclient.ConnectorConfig {
-	return artieclient.ConnectorConfig{
-		Host:                          d.Host.ValueString(),
-		DatabricksHttpPath:            d.HttpPath.ValueString(),
-		DatabricksPersonalAccessToken: d.PersonalAccessToken.ValueString(),
-		DatabricksClientID:            d.ClientID.ValueString(),
-		DatabricksClientSecret:        d.ClientSecret.ValueString(),
-		DatabricksVolume:              d.Volume.ValueString(),
-	}
-}
-
-func DatabricksSharedConfigFromAPIModel(apiModel artieclient.ConnectorConfig) *DatabricksSharedConfig {
-	return &DatabricksSharedConfig{
-		Host:                types.StringValue(apiModel.Host),
-		HttpPath:            types.StringValue(apiModel.DatabricksHttpPath),
-		PersonalAccessToken: types.StringValue(apiModel.DatabricksPersonalAccessToken),
-		ClientID:            types.StringValue(apiModel.DatabricksClientID),
-		ClientSecret:        types.StringValue(apiModel.DatabricksClientSecret),
-		Volume:              types.StringValue(apiModel.DatabricksVolume),
-	}
-}
-
-type GCSSharedConfig struct {
-	ProjectID       types.String `tfsdk:"project_id"`
-	CredentialsData types.String `tfsdk:"credentials_data"`
-}
-
-func (g GCSSharedConfig) ToAPIModel() artieclient.ConnectorConfig {
-	return artieclient.ConnectorConfig{
-		GCPProjectID:       g.ProjectID.ValueString(),
-		GCPCredentialsData: g.CredentialsData.ValueString(),
-	}
-}
-
-func GCSSharedConfigFromAPIModel(apiModel artieclient.ConnectorConfig) *GCSSharedConfig {
-	return &GCSSharedConfig{
-		ProjectID:       types.StringValue(apiModel.GCPProjectID),
-		CredentialsData: types.StringValue(apiModel.GCPCredentialsData),
-	}
-}
-
-type IcebergSharedConfig struct {
-	Provider types.String `tfsdk:"provider"`
-
-	// S3 Tables fields:
-	AwsAccessKeyID     types.String `tfsdk:"access_key_id"`
-	AwsSecretAccessKey types.String `tfsdk:"secret_access_key"`
-	BucketARN          types.String `tfsdk:"bucket_arn"`
-	Region             types.String `tfsdk:"region"`
-
-	// REST Catalog fields:
-	URI        types.String `tfsdk:"uri"`
-	Token      types.String `tfsdk:"token"`
-	Credential types.String `tfsdk:"credential"`
-	AuthURI    types.String `tfsdk:"auth_uri"`
-	Scope      types.String `tfsdk:"scope"`
-	Warehouse  types.String `tfsdk:"warehouse"`
-	Prefix     types.String `tfsdk:"prefix"`
-}
-
-func (i IcebergSharedConfig) ToAPIModel() artieclient.ConnectorConfig {
-	return artieclient.ConnectorConfig{
-		IcebergProvider:    i.Provider.ValueString(),
-		AWSAccessKeyID:     i.AwsAccessKeyID.ValueString(),
-		AWSSecretAccessKey: i.AwsSecretAccessKey.ValueString(),
-		IcebergBucketARN:   i.BucketARN.ValueString(),
-		IcebergRegion:      i.Region.ValueString(),
-		IcebergURI:         i.URI.ValueString(),
-		IcebergToken:       i.Token.ValueString(),
-		IcebergCredential:  i.Credential.ValueString(),
-		IcebergAuthURI:     i.AuthURI.ValueString(),
-		IcebergScope:       i.Scope.ValueString(),
-		IcebergWarehouse:   i.Warehouse.ValueString(),
-		IcebergPrefix:      i.Prefix.ValueString(),
-	}
-}
-
-func IcebergSharedConfigFromAPIModel(apiModel artieclient.ConnectorConfig) *IcebergSharedConfig {
-	return &IcebergSharedConfig{
-		Provider:           types.StringValue(apiModel.IcebergProvider),
-		AwsAccessKeyID:     types.StringValue(apiModel.AWSAccessKeyID),
-		AwsSecretAccessKey: types.StringValue(apiModel.AWSSecretAccessKey),
-		BucketARN:          types.StringValue(apiModel.IcebergBucketARN),
-		Region:             types.StringValue(apiModel.IcebergRegion),
-		URI:                types.StringValue(apiModel.IcebergURI),
-		Token:              types.StringValue(apiModel.IcebergToken),
-		Credential:         types.StringValue(apiModel.IcebergCredential),
-		AuthURI:            types.StringValue(apiModel.IcebergAuthURI),
-		Scope:              types.StringValue(apiModel.IcebergScope),
-		Warehouse:          types.StringValue(apiModel.IcebergWarehouse),
-		Prefix:             types.StringValue(apiModel.IcebergPrefix),
-	}
-}
-
-type KeyspacesSharedConfig struct {
-	Host               types.String `tfsdk:"host"`
-	Port               types.Int32  `tfsdk:"port"`
-	Region             types.String `tfsdk:"region"`
-	AwsAccessKeyID     types.String `tfsdk:"access_key_id"`
-	AwsSecretAccessKey types.String `tfsdk:"secret_access_key"`
-	RoleARN            types.String `tfsdk:"role_arn"`
-	ExternalID         types.String `tfsdk:"external_id"`
-}
-
-func (k KeyspacesSharedConfig) ToAPIModel() artieclient.ConnectorConfig {
-	return artieclient.ConnectorConfig{
-		Host:               k.Host.ValueString(),
-		Port:               k.Port.ValueInt32(),
-		AWSRegion:          k.Region.ValueString(),
-		AWSAccessKeyID:     k.AwsAccessKeyID.ValueString(),
-		AWSSecretAccessKey: k.AwsSecretAccessKey.ValueString(),
-		AWSRoleARN:         k.RoleARN.ValueString(),
-		AWSExternalID:      k.ExternalID.ValueString(),
-	}
-}
-
-func KeyspacesSharedConfigFromAPIModel(apiModel artieclient.ConnectorConfig) *KeyspacesSharedConfig {
-	return &KeyspacesSharedConfig{
-		Host:               types.StringValue(apiModel.Host),
-		Port:               types.Int32Value(apiModel.Port),
-		Region:             types.StringValue(apiModel.AWSRegion),
-		AwsAccessKeyID:     types.StringValue(apiModel.AWSAccessKeyID),
-		AwsSecretAccessKey: types.StringValue(apiModel.AWSSecretAccessKey),
-		RoleARN:            types.StringValue(apiModel.AWSRoleARN),
-		ExternalID:         types.StringValue(apiModel.AWSExternalID),
-	}
-}
@@ -0,0 +1,60 @@
+package tfmodels
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-artie/internal/lib"
+	"terraform-provider-artie/internal/openapi"
+)
+
+type ConnectorDatabasesDataSource struct {
+	ConnectorUUID types.String `tfsdk:"connector_uuid"`
+	Databases     types.List   `tfsdk:"databases"`
+}
+
+type ConnectorSchemasDataSource struct {
+	ConnectorUUID types.String `tfsdk:"connector_uuid"`
+	DatabaseName  types.String `tfsdk:"database_name"`
+	Schemas       types.List   `tfsdk:"schemas"`
+}
+
+type ConnectorTablesDataSource struct {
+	ConnectorUUID types.String     `tfsdk:"connector_uuid"`
+	DatabaseName  types.String     `tfsdk:"database_name"`
+	SchemaName    types.String     `tfsdk:"schema_name"`
+	ForceRefresh  types.Bool       `tfsdk:"force_refresh"`
+	Tables        []ConnectorTable `tfsdk:"tables"`
+}
+
+type ConnectorTable struct {
+	Name       types.String      `tfsdk:"name"`
+	Schema     types.String      `tfsdk:"schema"`
+	IsView     types.Bool        `tfsdk:"is_view"`
+	Unreadable types.Bool        `tfsdk:"unreadable"`
+	Columns    []ConnectorColumn `tfsdk:"columns"`
+}
+
+type ConnectorColumn struct {
+	Name         types.String `tfsdk:"name"`
+	DataType     types.String `tfsdk:"data_type"`
+	IsPrimaryKey types.Bool   `tfsdk:"is_primary_key"`
+}
+
+func ConnectorTableFromAPIModel(apiModel openapi.RouterConnectorTable) ConnectorTable {
+	table := ConnectorTable{
+		Name:       types.StringValue(lib.RemovePtr(apiModel.Name)),
+		Schema:     types.StringValue(lib.RemovePtr(apiModel.Schema)),
+		IsView:     boolPointerValueOrFalse(apiModel.IsView),
+		Unreadable: boolPointerValueOrFalse(apiModel.Unreadable),
+		Columns:    []ConnectorColumn{},
+	}
+	for _, column := range lib.RemovePtr(apiModel.Columns) {
+		metadata := lib.RemovePtr(column.Metadata)
+		table.Columns = append(table.Columns, ConnectorColumn{
+			Name:         types.StringValue(lib.RemovePtr(column.Name)),
+			DataType:     types.StringValue(lib.RemovePtr(metadata.DataType)),
+			IsPrimaryKey: boolPointerValueOrFalse(metadata.IsPrimaryKey),
+		})
+	}
+	return table
+}
@@ -17,7 +17,7 @@ type EncryptionKey struct {
 }
 
 func (e EncryptionKey) ToAPIBaseModel() (artieclient.BaseEncryptionKey, diag.Diagnostics) {
-	kmsKeyUUID, diags := parseOptionalUUID(e.KMSKeyUUID)
+	kmsKeyUUID, diags := ParseOptionalUUID(e.KMSKeyUUID)
 	if diags.HasError() {
 		return artieclient.BaseEncryptionKey{}, diags
 	}
@@ -0,0 +1,77 @@
+package tfmodels
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-artie/internal/openapi"
+)
+
+type IngestionAPIKey struct {
+	UUID             types.String `tfsdk:"uuid"`
+	Name             types.String `tfsdk:"name"`
+	SourceReaderUUID types.String `tfsdk:"source_reader_uuid"`
+	ExpiresAt        types.String `tfsdk:"expires_at"`
+	RotationTriggers types.Map    `tfsdk:"rotation_triggers"`
+	Last4            types.String `tfsdk:"last4"`
+	Secret           types.String `tfsdk:"secret"`
+}
+
+// ParseExpiresAt parses expires_at, which must be an RFC 3339 timestamp if it's set.
+func (k IngestionAPIKey) ParseExpiresAt() (*time.Time, diag.Diagnostics) {
+	if k.ExpiresAt.ValueString() == "" {
+		return nil, nil
+	}
+
+	expiresAt, err := time.Parse(time.RFC3339, k.ExpiresAt.ValueString())
+	if err != nil {
+		return nil, []diag.Diagnostic{diag.NewErrorDiagnostic("Unable to parse expires_at", fmt.Sprintf("%q is not an RFC 3339 timestamp: %s", k.ExpiresAt.ValueString(), err))}
+	}
+	return &expiresAt, nil
+}
+
+func (k IngestionAPIKey) ToCreateRequest() (openapi.RouterCreateIngestionAPIKeyRequest, diag.Diagnostics) {
+	sourceReaderUUID, diags := ParseUUID(k.SourceReaderUUID)
+	if diags.HasError() {
+		return openapi.RouterCreateIngestionAPIKeyRequest{}, diags
+	}
+
+	name := k.Name.ValueString()
+	return openapi.RouterCreateIngestionAPIKeyRequest{Name: &name, SourceReaderUUID: sourceReaderUUID}, nil
+}
+
+func (k IngestionAPIKey) ToUpdateRequest() (openapi.RouterUpdateIngestionAPIKeyRequest, diag.Diagnostics) {
+	expiresAt, diags := k.ParseExpiresAt()
+	if diags.HasError() {
+		return openapi.RouterUpdateIngestionAPIKeyRequest{}, diags
+	}
+
+	name := k.Name.ValueString()
+	return openapi.RouterUpdateIngestionAPIKeyRequest{Name: &name, ExpiresAt: expiresAt}, nil
+}
+
+// IngestionAPIKeyFromAPIModel converts an ingestion API key from the API. The API never returns the secret (except when
+// the key is created) or the rotation triggers, so those are copied from prior, as is expires_at if it refers to the
+// same time as the API's value but is formatted differently.
+func IngestionAPIKeyFromAPIModel(apiModel openapi.PayloadsIngestionAPIKey, prior IngestionAPIKey) IngestionAPIKey {
+	expiresAt := types.StringNull()
+	if apiModel.ExpiresAt != nil {
+		expiresAt = types.StringValue(apiModel.ExpiresAt.Format(time.RFC3339))
+		if priorExpiresAt, diags := prior.ParseExpiresAt(); !diags.HasError() && priorExpiresAt != nil && priorExpiresAt.Equal(*apiModel.ExpiresAt) {
+			expiresAt = prior.ExpiresAt
+		}
+	}
+
+	return IngestionAPIKey{
+		UUID:             types.StringValue(apiModel.Uuid.String()),
+		Name:             types.StringValue(apiModel.Name),
+		SourceReaderUUID: types.StringValue(apiModel.SourceReaderUUID.String()),
+		ExpiresAt:        expiresAt,
+		RotationTriggers: prior.RotationTriggers,
+		Last4:            types.StringValue(apiModel.Last4),
+		Secret:           prior.Secret,
+	}
+}
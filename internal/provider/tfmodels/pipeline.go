@@ -2,13 +2,16 @@ package tfmodels
 
 import (
 	"context"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 
 	"terraform-provider-artie/internal/artieclient"
+	"terraform-provider-artie/internal/openapi"
 )
 
 type PipelineDestinationConfig struct {
@@ -51,6 +54,23 @@ func PipelineDestinationConfigFromAPIModel(apiModel artieclient.DestinationConfi
 	}
 }
 
+// PipelineStartBehavior is how a pipeline is started after it's created or updated. Artie doesn't store it.
+type PipelineStartBehavior struct {
+	SkipBackfill types.Bool `tfsdk:"skip_backfill"`
+	KeepPaused   types.Bool `tfsdk:"keep_paused"`
+}
+
+func (b *PipelineStartBehavior) ToAPIModel() openapi.RouterPipelineStartRequest {
+	if b == nil {
+		return openapi.RouterPipelineStartRequest{}
+	}
+
+	return openapi.RouterPipelineStartRequest{
+		DisableBackFill: b.SkipBackfill.ValueBoolPointer(),
+		KeepPaused:      b.KeepPaused.ValueBoolPointer(),
+	}
+}
+
 type FlushConfig struct {
 	FlushIntervalSeconds types.Int64 `tfsdk:"flush_interval_seconds"`
 	BufferRows           types.Int64 `tfsdk:"buffer_rows"`
@@ -155,6 +175,19 @@ type Pipeline struct {
 	DataPlaneName            types.String               `tfsdk:"data_plane_name"`
 	Tables                   types.Map                  `tfsdk:"tables"`
 	StatusOverride           types.String               `tfsdk:"status_override"`
+	DesiredStatus            types.String               `tfsdk:"desired_status"`
+	StartBehavior            *PipelineStartBehavior     `tfsdk:"start_behavior"`
+	WaitForDeployment        types.Bool                 `tfsdk:"wait_for_deployment"`
+	Timeouts                 timeouts.Value             `tfsdk:"timeouts"`
+
+	// Runtime state, which is managed by Artie
+	SourceType           types.String `tfsdk:"source_type"`
+	Status               types.String `tfsdk:"status"`
+	IsDeploying          types.Bool   `tfsdk:"is_deploying"`
+	HasUndeployedChanges types.Bool   `tfsdk:"has_undeployed_changes"`
+	HasBackfillingTables types.Bool   `tfsdk:"has_backfilling_tables"`
+	LastDeployedAt       types.String `tfsdk:"last_deployed_at"`
+	LastUpdatedAt        types.String `tfsdk:"last_updated_at"`
 
 	// Advanced settings
 	FlushConfig                                  types.Object `tfsdk:"flush_rules"`
@@ -201,31 +234,31 @@ func (p Pipeline) ToAPIBaseModel(ctx context.Context) (artieclient.BasePipeline,
 		apiTables = append(apiTables, apiTable)
 	}
 
-	sourceReaderUUID, sourceReaderDiags := parseOptionalUUID(p.SourceReaderUUID)
+	sourceReaderUUID, sourceReaderDiags := ParseOptionalUUID(p.SourceReaderUUID)
 	diags.Append(sourceReaderDiags...)
 	if diags.HasError() {
 		return artieclient.BasePipeline{}, diags
 	}
 
-	destinationUUID, destDiags := parseOptionalUUID(p.DestinationUUID)
+	destinationUUID, destDiags := ParseOptionalUUID(p.DestinationUUID)
 	diags.Append(destDiags...)
 	if diags.HasError() {
 		return artieclient.BasePipeline{}, diags
 	}
 
-	snowflakeEcoScheduleUUID, snowflakeDiags := parseOptionalUUID(p.SnowflakeEcoScheduleUUID)
+	snowflakeEcoScheduleUUID, snowflakeDiags := ParseOptionalUUID(p.SnowflakeEcoScheduleUUID)
 	diags.Append(snowflakeDiags...)
 	if diags.HasError() {
 		return artieclient.BasePipeline{}, diags
 	}
 
-	encryptionKeyUUID, encryptionKeyDiags := parseOptionalUUID(p.EncryptionKeyUUID)
+	encryptionKeyUUID, encryptionKeyDiags := ParseOptionalUUID(p.EncryptionKeyUUID)
 	diags.Append(encryptionKeyDiags...)
 	if diags.HasError() {
 		return artieclient.BasePipeline{}, diags
 	}
 
-	columnHashingSaltUUID, columnHashingSaltDiags := parseOptionalUUID(p.ColumnHashingSaltUUID)
+	columnHashingSaltUUID, columnHashingSaltDiags := ParseOptionalUUID(p.ColumnHashingSaltUUID)
 	diags.Append(columnHashingSaltDiags...)
 	if diags.HasError() {
 		return artieclient.BasePipeline{}, diags
@@ -295,7 +328,7 @@ func (p Pipeline) ToAPIModel(ctx context.Context) (artieclient.Pipeline, diag.Di
 		return artieclient.Pipeline{}, diags
 	}
 
-	uuid, uuidDiags := parseUUID(p.UUID)
+	uuid, uuidDiags := ParseUUID(p.UUID)
 	diags.Append(uuidDiags...)
 	if diags.HasError() {
 		return artieclient.Pipeline{}, diags
@@ -307,7 +340,7 @@ func (p Pipeline) ToAPIModel(ctx context.Context) (artieclient.Pipeline, diag.Di
 	}, diags
 }
 
-func PipelineFromAPIModel(ctx context.Context, apiModel artieclient.Pipeline) (Pipeline, diag.Diagnostics) {
+func PipelineFromAPIModel(ctx context.Context, apiModel artieclient.PipelineDetails) (Pipeline, diag.Diagnostics) {
 	tables, diags := TablesFromAPIModel(ctx, apiModel.Tables)
 	if diags.HasError() {
 		return Pipeline{}, diags
@@ -449,16 +482,25 @@ func PipelineFromAPIModel(ctx context.Context, apiModel artieclient.Pipeline) (P
 		}
 	}
 
+	status := types.StringNull()
+	if apiModel.Status != "" {
+		status = types.StringValue(string(apiModel.Status))
+	}
+	lastDeployedAt := types.StringNull()
+	if apiModel.LastDeployedAt != nil {
+		lastDeployedAt = types.StringValue(apiModel.LastDeployedAt.Format(time.RFC3339))
+	}
+
 	return Pipeline{
 		UUID:                     types.StringValue(apiModel.UUID.String()),
 		Name:                     types.StringValue(apiModel.Name),
 		Tables:                   tablesMap,
-		SourceReaderUUID:         optionalUUIDToStringValue(apiModel.SourceReaderUUID),
-		DestinationUUID:          optionalUUIDToStringValue(apiModel.DestinationUUID),
+		SourceReaderUUID:         OptionalUUIDToStringValue(apiModel.SourceReaderUUID),
+		DestinationUUID:          OptionalUUIDToStringValue(apiModel.DestinationUUID),
 		DestinationConfig:        &destinationConfig,
-		SnowflakeEcoScheduleUUID: optionalUUIDToStringValue(apiModel.SnowflakeEcoScheduleUUID),
-		EncryptionKeyUUID:        optionalUUIDToStringValue(apiModel.EncryptionKeyUUID),
-		ColumnHashingSaltUUID:    optionalUUIDToStringValue(apiModel.ColumnHashingSaltUUID),
+		SnowflakeEcoScheduleUUID: OptionalUUIDToStringValue(apiModel.SnowflakeEcoScheduleUUID),
+		EncryptionKeyUUID:        OptionalUUIDToStringValue(apiModel.EncryptionKeyUUID),
+		ColumnHashingSaltUUID:    OptionalUUIDToStringValue(apiModel.ColumnHashingSaltUUID),
 		DataPlaneName:            types.StringValue(apiModel.DataPlaneName),
 
 		// Advanced settings:
@@ -487,5 +529,14 @@ func PipelineFromAPIModel(ctx context.Context, apiModel artieclient.Pipeline) (P
 		TurboWarehouse:                               turboWarehouse,
 		TurboRowThreshold:                            turboRowThreshold,
 		TurboLatencyThresholdMinutes:                 turboLatencyThresholdMinutes,
+
+		// Runtime state:
+		SourceType:           types.StringValue(string(apiModel.SourceType)),
+		Status:               status,
+		IsDeploying:          types.BoolValue(apiModel.IsDeploying),
+		HasUndeployedChanges: types.BoolValue(apiModel.HasUndeployedChanges),
+		HasBackfillingTables: types.BoolValue(apiModel.HasBackfillingTables),
+		LastDeployedAt:       lastDeployedAt,
+		LastUpdatedAt:        types.StringValue(apiModel.LastUpdatedAt.Format(time.RFC3339)),
 	}, diags
 }
@@ -0,0 +1,53 @@
+package tfmodels
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-artie/internal/openapi"
+)
+
+// PipelineSummary is a pipeline as listed by the artie_pipelines data source.
+type PipelineSummary struct {
+	UUID                     types.String `tfsdk:"uuid"`
+	Name                     types.String `tfsdk:"name"`
+	Status                   types.String `tfsdk:"status"`
+	SourceType               types.String `tfsdk:"source_type"`
+	SourceReaderUUID         types.String `tfsdk:"source_reader_uuid"`
+	DestinationConnectorUUID types.String `tfsdk:"destination_connector_uuid"`
+	DataPlaneName            types.String `tfsdk:"data_plane_name"`
+	HasUndeployedChanges     types.Bool   `tfsdk:"has_undeployed_changes"`
+	IsDeploying              types.Bool   `tfsdk:"is_deploying"`
+	LastDeployedAt           types.String `tfsdk:"last_deployed_at"`
+}
+
+func PipelineSummaryFromAPIModel(apiModel openapi.PayloadsLightPipeline) PipelineSummary {
+	summary := PipelineSummary{
+		UUID:                     types.StringValue(apiModel.Uuid.String()),
+		Name:                     types.StringValue(apiModel.Name),
+		Status:                   types.StringNull(),
+		SourceType:               types.StringValue(string(apiModel.SourceType)),
+		SourceReaderUUID:         OptionalUUIDToStringValue(apiModel.SourceReaderUUID),
+		DestinationConnectorUUID: OptionalUUIDToStringValue(apiModel.DestinationUUID),
+		DataPlaneName:            types.StringValue(apiModel.DataPlaneName),
+		HasUndeployedChanges:     types.BoolValue(apiModel.HasUndeployedChanges),
+		IsDeploying:              types.BoolValue(apiModel.IsDeploying),
+		LastDeployedAt:           types.StringNull(),
+	}
+	if apiModel.Status != nil {
+		summary.Status = types.StringValue(string(*apiModel.Status))
+	}
+	if apiModel.LastDeployedAt != nil {
+		summary.LastDeployedAt = types.StringValue(apiModel.LastDeployedAt.Format(time.RFC3339))
+	}
+	return summary
+}
+
+type PipelinesDataSource struct {
+	NameRegex     types.String      `tfsdk:"name_regex"`
+	Status        types.String      `tfsdk:"status"`
+	SourceType    types.String      `tfsdk:"source_type"`
+	DataPlaneName types.String      `tfsdk:"data_plane_name"`
+	Pipelines     []PipelineSummary `tfsdk:"pipelines"`
+}
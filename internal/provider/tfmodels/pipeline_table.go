@@ -122,6 +122,11 @@ type Table struct {
 	RangeBatchSize       types.Int64  `tfsdk:"range_batch_size"`
 	SkipBackfill         types.Bool   `tfsdk:"skip_backfill"`
 	SkipNoOpUpdates      types.Bool   `tfsdk:"skip_no_op_updates"`
+	RedisKeyPattern      types.String `tfsdk:"redis_key_pattern"`
+
+	// Runtime state, which is managed by Artie
+	Status             types.String `tfsdk:"status"`
+	HistoryTableStatus types.String `tfsdk:"history_table_status"`
 }
 
 var TableAttrTypes = map[string]attr.Type{
@@ -153,13 +158,16 @@ var TableAttrTypes = map[string]attr.Type{
 	"range_batch_size":       types.Int64Type,
 	"skip_backfill":          types.BoolType,
 	"skip_no_op_updates":     types.BoolType,
+	"redis_key_pattern":      types.StringType,
+	"status":                 types.StringType,
+	"history_table_status":   types.StringType,
 }
 
 func (t Table) ToAPIModel(ctx context.Context) (artieclient.Table, diag.Diagnostics) {
 	tableUUID := uuid.Nil
 	var diags diag.Diagnostics
 	if t.UUID.ValueString() != "" {
-		tableUUID, diags = parseUUID(t.UUID)
+		tableUUID, diags = ParseUUID(t.UUID)
 	}
 
 	colsToExclude, excludeDiags := parseOptionalList[string](ctx, t.ExcludeColumns)
@@ -247,6 +255,7 @@ func (t Table) ToAPIModel(ctx context.Context) (artieclient.Table, diag.Diagnost
 			RangeSettings:              clientRangeSettings,
 			SkipBackfill:               t.SkipBackfill.ValueBoolPointer(),
 			SkipNoOpUpdates:            t.SkipNoOpUpdates.ValueBoolPointer(),
+			RedisKeyPattern:            t.RedisKeyPattern.ValueStringPointer(),
 		},
 	}, diags
 }
@@ -337,6 +346,9 @@ func TablesFromAPIModel(ctx context.Context, apiModelTables []artieclient.Table)
 			RangeBatchSize:       rangeBatchSize,
 			SkipBackfill:         boolPointerValueOrFalse(apiTable.AdvancedSettings.SkipBackfill),
 			SkipNoOpUpdates:      boolPointerValueOrFalse(apiTable.AdvancedSettings.SkipNoOpUpdates),
+			RedisKeyPattern:      types.StringPointerValue(apiTable.AdvancedSettings.RedisKeyPattern),
+			Status:               types.StringPointerValue(apiTable.Status),
+			HistoryTableStatus:   types.StringPointerValue(apiTable.HistoryTableStatus),
 		}
 	}
 
@@ -193,3 +193,19 @@ func TestBoolPointerValueOrFalse(t *testing.T) {
 	assert.False(t, boolPointerValueOrFalse(nil).IsNull(), "nil should coalesce to a known false, not null")
 	assert.False(t, boolPointerValueOrFalse(nil).ValueBool())
 }
+
+func TestTable_RedisKeyPatternRoundTrip(t *testing.T) {
+	table := Table{Name: types.StringValue("user"), RedisKeyPattern: types.StringValue("user:*")}
+	apiTable, diags := table.ToAPIModel(t.Context())
+	assert.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+	assert.Equal(t, "user:*", *apiTable.AdvancedSettings.RedisKeyPattern)
+
+	tables, diags := TablesFromAPIModel(t.Context(), []artieclient.Table{apiTable})
+	assert.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+	assert.Equal(t, "user:*", tables["user"].RedisKeyPattern.ValueString())
+
+	// Tables without a key pattern read back as null.
+	tables, diags = TablesFromAPIModel(t.Context(), []artieclient.Table{{Name: "orders"}})
+	assert.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+	assert.True(t, tables["orders"].RedisKeyPattern.IsNull())
+}
@@ -2,6 +2,7 @@ package tfmodels
 
 import (
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -9,10 +10,16 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"terraform-provider-artie/internal/artieclient"
+	"terraform-provider-artie/internal/openapi"
 )
 
 func ptr[T any](v T) *T { return &v }
 
+// testDetails returns pipeline with an empty runtime state, as if it had just been created.
+func testDetails(pipeline artieclient.Pipeline) artieclient.PipelineDetails {
+	return artieclient.PipelineDetails{Pipeline: pipeline}
+}
+
 func TestPipelineAutoEnableHistoryForNewTables(t *testing.T) {
 	tablesMap, mapDiags := types.MapValueFrom(t.Context(), types.ObjectType{AttrTypes: TableAttrTypes}, map[string]Table{})
 	assert.False(t, mapDiags.HasError(), "unexpected diags: %v", mapDiags)
@@ -37,19 +44,19 @@ func TestPipelineAutoEnableHistoryForNewTables(t *testing.T) {
 		},
 	}
 
-	model, diags := PipelineFromAPIModel(t.Context(), base)
+	model, diags := PipelineFromAPIModel(t.Context(), testDetails(base))
 	assert.False(t, diags.HasError(), "unexpected diags: %v", diags)
 	assert.False(t, model.AutoEnableHistoryForNewTables.IsNull(), "auto_enable_history_for_new_tables should not be null when omitted")
 	assert.False(t, model.AutoEnableHistoryForNewTables.ValueBool())
 
 	base.AdvancedSettings.AutoEnableHistoryForNewTables = ptr(false)
-	model, diags = PipelineFromAPIModel(t.Context(), base)
+	model, diags = PipelineFromAPIModel(t.Context(), testDetails(base))
 	assert.False(t, diags.HasError(), "unexpected diags: %v", diags)
 	assert.False(t, model.AutoEnableHistoryForNewTables.IsNull(), "auto_enable_history_for_new_tables should not be null when false")
 	assert.False(t, model.AutoEnableHistoryForNewTables.ValueBool())
 
 	base.AdvancedSettings.AutoEnableHistoryForNewTables = ptr(true)
-	model, diags = PipelineFromAPIModel(t.Context(), base)
+	model, diags = PipelineFromAPIModel(t.Context(), testDetails(base))
 	assert.False(t, diags.HasError(), "unexpected diags: %v", diags)
 	assert.True(t, model.AutoEnableHistoryForNewTables.ValueBool())
 }
@@ -70,14 +77,14 @@ func TestPipelineAutoReplicateIgnoreRegex(t *testing.T) {
 	assert.Equal(t, "^public\\.tmp_.*", *apiModel.AdvancedSettings.AutoReplicateIgnoreRegex)
 
 	apiModel.AdvancedSettings.AutoReplicateIgnoreRegex = ptr("^public\\.archive_.*")
-	model, diags := PipelineFromAPIModel(t.Context(), artieclient.Pipeline{
+	model, diags := PipelineFromAPIModel(t.Context(), testDetails(artieclient.Pipeline{
 		UUID: uuid.New(),
 		BasePipeline: artieclient.BasePipeline{
 			Name:             "test",
 			Tables:           []artieclient.Table{},
 			AdvancedSettings: apiModel.AdvancedSettings,
 		},
-	})
+	}))
 	assert.False(t, diags.HasError(), "unexpected diags: %v", diags)
 	assert.Equal(t, "^public\\.archive_.*", model.AutoReplicateIgnoreRegex.ValueString())
 }
@@ -98,14 +105,14 @@ func TestPipelineAutoEnableHistoryIgnoreRegex(t *testing.T) {
 	assert.Equal(t, "^audit\\..*", *apiModel.AdvancedSettings.AutoEnableHistoryIgnoreRegex)
 
 	apiModel.AdvancedSettings.AutoEnableHistoryIgnoreRegex = ptr("^archive\\..*")
-	model, diags := PipelineFromAPIModel(t.Context(), artieclient.Pipeline{
+	model, diags := PipelineFromAPIModel(t.Context(), testDetails(artieclient.Pipeline{
 		UUID: uuid.New(),
 		BasePipeline: artieclient.BasePipeline{
 			Name:             "test",
 			Tables:           []artieclient.Table{},
 			AdvancedSettings: apiModel.AdvancedSettings,
 		},
-	})
+	}))
 	assert.False(t, diags.HasError(), "unexpected diags: %v", diags)
 	assert.Equal(t, "^archive\\..*", model.AutoEnableHistoryIgnoreRegex.ValueString())
 }
@@ -126,7 +133,7 @@ func TestPipelineFromAPIModel_DisableAlertsReadsBackAsFalse(t *testing.T) {
 
 	{
 		// DisableAlerts omitted (nil) -> false
-		pipeline, diags := PipelineFromAPIModel(t.Context(), base)
+		pipeline, diags := PipelineFromAPIModel(t.Context(), testDetails(base))
 		assert.False(t, diags.HasError(), "unexpected diags: %v", diags)
 		assert.False(t, pipeline.DisableAlerts.IsNull(), "disable_alerts should not be null when omitted")
 		assert.False(t, pipeline.DisableAlerts.ValueBool())
@@ -134,7 +141,7 @@ func TestPipelineFromAPIModel_DisableAlertsReadsBackAsFalse(t *testing.T) {
 	{
 		// DisableAlerts explicitly false -> false
 		base.AdvancedSettings.DisableAlerts = ptr(false)
-		pipeline, diags := PipelineFromAPIModel(t.Context(), base)
+		pipeline, diags := PipelineFromAPIModel(t.Context(), testDetails(base))
 		assert.False(t, diags.HasError(), "unexpected diags: %v", diags)
 		assert.False(t, pipeline.DisableAlerts.IsNull(), "disable_alerts should not be null when false")
 		assert.False(t, pipeline.DisableAlerts.ValueBool())
@@ -142,7 +149,7 @@ func TestPipelineFromAPIModel_DisableAlertsReadsBackAsFalse(t *testing.T) {
 	{
 		// DisableAlerts explicitly true -> true
 		base.AdvancedSettings.DisableAlerts = ptr(true)
-		pipeline, diags := PipelineFromAPIModel(t.Context(), base)
+		pipeline, diags := PipelineFromAPIModel(t.Context(), testDetails(base))
 		assert.False(t, diags.HasError(), "unexpected diags: %v", diags)
 		assert.True(t, pipeline.DisableAlerts.ValueBool())
 	}
@@ -214,7 +221,7 @@ func TestPipelineFromAPIModel_MaxConcurrentSnapshots(t *testing.T) {
 		},
 	}
 
-	pipeline, diags := PipelineFromAPIModel(t.Context(), apiModel)
+	pipeline, diags := PipelineFromAPIModel(t.Context(), testDetails(apiModel))
 	assert.False(t, diags.HasError(), "unexpected diags: %v", diags)
 	assert.Equal(t, int64(6), pipeline.MaxConcurrentSnapshots.ValueInt64())
 }
@@ -251,13 +258,61 @@ func TestPipelineFromAPIModel_TurboSettings(t *testing.T) {
 		},
 	}
 
-	pipeline, diags := PipelineFromAPIModel(t.Context(), apiModel)
+	pipeline, diags := PipelineFromAPIModel(t.Context(), testDetails(apiModel))
 	assert.False(t, diags.HasError(), "unexpected diags: %v", diags)
 	assert.Equal(t, "ARTIE_WEB_WH_LARGE", pipeline.TurboWarehouse.ValueString())
 	assert.Equal(t, int64(500000), pipeline.TurboRowThreshold.ValueInt64())
 	assert.Equal(t, int64(30), pipeline.TurboLatencyThresholdMinutes.ValueInt64())
 }
 
+func TestPipelineFromAPIModel_RuntimeState(t *testing.T) {
+	lastDeployedAt := time.Date(2025, 3, 4, 5, 6, 7, 0, time.UTC)
+	apiModel := artieclient.PipelineDetails{
+		Pipeline: artieclient.Pipeline{
+			UUID: uuid.New(),
+			BasePipeline: artieclient.BasePipeline{
+				Name: "test",
+				Tables: []artieclient.Table{
+					{Name: "account", Schema: "public", Status: ptr("backfilling")},
+					{Name: "company", Schema: "public", EnableHistoryMode: true, Status: ptr("streaming"), HistoryTableStatus: ptr("backfilling")},
+				},
+			},
+		},
+		SourceType:           "postgresql",
+		Status:               openapi.EnumsPipelineStatusRunning,
+		HasBackfillingTables: true,
+		LastDeployedAt:       &lastDeployedAt,
+		LastUpdatedAt:        lastDeployedAt.Add(time.Hour),
+	}
+
+	{
+		pipeline, diags := PipelineFromAPIModel(t.Context(), apiModel)
+		assert.False(t, diags.HasError(), "unexpected diags: %v", diags)
+		assert.Equal(t, types.StringValue("postgresql"), pipeline.SourceType)
+		assert.Equal(t, types.StringValue("running"), pipeline.Status)
+		assert.Equal(t, types.BoolValue(false), pipeline.IsDeploying)
+		assert.Equal(t, types.BoolValue(false), pipeline.HasUndeployedChanges)
+		assert.Equal(t, types.BoolValue(true), pipeline.HasBackfillingTables)
+		assert.Equal(t, types.StringValue("2025-03-04T05:06:07Z"), pipeline.LastDeployedAt)
+		assert.Equal(t, types.StringValue("2025-03-04T06:06:07Z"), pipeline.LastUpdatedAt)
+
+		tables := map[string]Table{}
+		diags = pipeline.Tables.ElementsAs(t.Context(), &tables, false)
+		assert.False(t, diags.HasError(), "unexpected diags: %v", diags)
+		assert.Equal(t, types.StringValue("backfilling"), tables["public.account"].Status)
+		assert.True(t, tables["public.account"].HistoryTableStatus.IsNull())
+		assert.Equal(t, types.StringValue("streaming"), tables["public.company"].Status)
+		assert.Equal(t, types.StringValue("backfilling"), tables["public.company"].HistoryTableStatus)
+	}
+	{
+		// Pipelines that have never been deployed have no status or deployment time.
+		pipeline, diags := PipelineFromAPIModel(t.Context(), testDetails(apiModel.Pipeline))
+		assert.False(t, diags.HasError(), "unexpected diags: %v", diags)
+		assert.True(t, pipeline.Status.IsNull())
+		assert.True(t, pipeline.LastDeployedAt.IsNull())
+	}
+}
+
 func TestFlushConfigFromAPIModel(t *testing.T) {
 	{
 		// zero object
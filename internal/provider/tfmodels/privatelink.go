@@ -38,7 +38,7 @@ func (p PrivateLink) ToAPIBaseModel(ctx context.Context) (artieclient.BasePrivat
 }
 
 func (p PrivateLink) ToAPIModel(ctx context.Context) (artieclient.PrivateLinkConnection, diag.Diagnostics) {
-	uuid, diags := parseUUID(p.UUID)
+	uuid, diags := ParseUUID(p.UUID)
 	if diags.HasError() {
 		return artieclient.PrivateLinkConnection{}, diags
 	}
@@ -108,6 +108,8 @@ type SourceReader struct {
 	EnableUnifyAcrossSchemas        types.Bool   `tfsdk:"enable_unify_across_schemas"`
 	UnifyAcrossSchemasRegex         types.String `tfsdk:"unify_across_schemas_regex"`
 	MSSQLReplicationMethod          types.String `tfsdk:"mssql_replication_method"`
+	MongoDBDisablePreImages         types.Bool   `tfsdk:"mongodb_disable_full_document_before_change"`
+	MongoDBClientSideDocumentLookup types.Bool   `tfsdk:"mongodb_enable_client_side_full_document_lookup"`
 	EnableUnifyAcrossDatabases      types.Bool   `tfsdk:"enable_unify_across_databases"`
 	DatabasesToUnify                types.List   `tfsdk:"databases_to_unify"`
 	DisableAutoFetchTables          types.Bool   `tfsdk:"disable_auto_fetch_tables"`
@@ -118,20 +120,22 @@ type SourceReader struct {
 func (s SourceReader) toAPISettings(ctx context.Context) (openapi.PayloadsSourceReaderSettingsPayload, diag.Diagnostics) {
 	var diags diag.Diagnostics
 	settings := openapi.PayloadsSourceReaderSettingsPayload{
-		BackfillBatchSize:            lib.ToPtr(int(s.BackfillBatchSize.ValueInt64())),
-		EnableHeartbeats:             s.EnableHeartbeats.ValueBoolPointer(),
-		OneTopicPerSchema:            s.OneTopicPerSchema.ValueBoolPointer(),
-		PublicationNameOverride:      s.PostgresPublicationNameOverride.ValueStringPointer(),
-		PublicationAutoCreateMode:    s.PostgresPublicationMode.ValueStringPointer(),
-		ReplicationSlotOverride:      s.PostgresReplicationSlotOverride.ValueStringPointer(),
-		PublishViaPartitionRoot:      s.PublishViaPartitionRoot.ValueBoolPointer(),
-		CompositeTypesAsText:         s.CompositeTypesAsText.ValueBoolPointer(),
-		UseAdvanceOnPrimaryKeepAlive: s.UseAdvanceOnPrimaryKeepAlive.ValueBoolPointer(),
-		UnifyAcrossSchemas:           s.EnableUnifyAcrossSchemas.ValueBoolPointer(),
-		UnifyAcrossSchemasRegex:      s.UnifyAcrossSchemasRegex.ValueStringPointer(),
-		MssqlReplicationMethod:       s.MSSQLReplicationMethod.ValueStringPointer(),
-		UnifyAcrossDatabases:         s.EnableUnifyAcrossDatabases.ValueBoolPointer(),
-		DisableAutoFetchTables:       s.DisableAutoFetchTables.ValueBoolPointer(),
+		BackfillBatchSize:                  lib.ToPtr(int(s.BackfillBatchSize.ValueInt64())),
+		EnableHeartbeats:                   s.EnableHeartbeats.ValueBoolPointer(),
+		OneTopicPerSchema:                  s.OneTopicPerSchema.ValueBoolPointer(),
+		PublicationNameOverride:            s.PostgresPublicationNameOverride.ValueStringPointer(),
+		PublicationAutoCreateMode:          s.PostgresPublicationMode.ValueStringPointer(),
+		ReplicationSlotOverride:            s.PostgresReplicationSlotOverride.ValueStringPointer(),
+		PublishViaPartitionRoot:            s.PublishViaPartitionRoot.ValueBoolPointer(),
+		CompositeTypesAsText:               s.CompositeTypesAsText.ValueBoolPointer(),
+		UseAdvanceOnPrimaryKeepAlive:       s.UseAdvanceOnPrimaryKeepAlive.ValueBoolPointer(),
+		UnifyAcrossSchemas:                 s.EnableUnifyAcrossSchemas.ValueBoolPointer(),
+		UnifyAcrossSchemasRegex:            s.UnifyAcrossSchemasRegex.ValueStringPointer(),
+		MssqlReplicationMethod:             s.MSSQLReplicationMethod.ValueStringPointer(),
+		DisableFullDocumentBeforeChange:    knownBoolPointer(s.MongoDBDisablePreImages),
+		EnableClientSideFullDocumentLookup: knownBoolPointer(s.MongoDBClientSideDocumentLookup),
+		UnifyAcrossDatabases:               s.EnableUnifyAcrossDatabases.ValueBoolPointer(),
+		DisableAutoFetchTables:             s.DisableAutoFetchTables.ValueBoolPointer(),
 	}
 
 	if IsKnown(s.MessageCompression) {
@@ -188,7 +192,7 @@ func SourceReaderCreateRequestFromAPIModel(model openapi.PayloadsSourceReader) o
 func (s SourceReader) ToAPIPayload(ctx context.Context) (openapi.PayloadsSourceReader, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
-	connectorUUID, connDiags := parseUUID(s.ConnectorUUID)
+	connectorUUID, connDiags := ParseUUID(s.ConnectorUUID)
 	diags.Append(connDiags...)
 	if diags.HasError() {
 		return openapi.PayloadsSourceReader{}, diags
@@ -216,7 +220,7 @@ func (s SourceReader) ToAPIPayload(ctx context.Context) (openapi.PayloadsSourceR
 }
 
 func (s SourceReader) ToAPIModel(ctx context.Context) (openapi.PayloadsSourceReader, diag.Diagnostics) {
-	uuid, diags := parseUUID(s.UUID)
+	uuid, diags := ParseUUID(s.UUID)
 	if diags.HasError() {
 		return openapi.PayloadsSourceReader{}, diags
 	}
@@ -263,6 +267,8 @@ func SourceReaderFromAPIModel(ctx context.Context, apiModel openapi.PayloadsSour
 		EnableUnifyAcrossSchemas:        types.BoolValue(lib.RemovePtr(apiModel.Settings.UnifyAcrossSchemas)),
 		UnifyAcrossSchemasRegex:         types.StringPointerValue(apiModel.Settings.UnifyAcrossSchemasRegex),
 		MSSQLReplicationMethod:          types.StringValue(lib.RemovePtr(apiModel.Settings.MssqlReplicationMethod)),
+		MongoDBDisablePreImages:         types.BoolPointerValue(apiModel.Settings.DisableFullDocumentBeforeChange),
+		MongoDBClientSideDocumentLookup: types.BoolPointerValue(apiModel.Settings.EnableClientSideFullDocumentLookup),
 		EnableUnifyAcrossDatabases:      types.BoolValue(lib.RemovePtr(apiModel.Settings.UnifyAcrossDatabases)),
 		DatabasesToUnify:                databasesToUnify,
 		DisableAutoFetchTables:          types.BoolValue(lib.RemovePtr(apiModel.Settings.DisableAutoFetchTables)),
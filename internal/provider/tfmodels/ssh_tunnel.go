@@ -27,7 +27,7 @@ func (s SSHTunnel) ToAPIBaseModel() artieclient.BaseSSHTunnel {
 }
 
 func (s SSHTunnel) ToAPIModel() (artieclient.SSHTunnel, diag.Diagnostics) {
-	uuid, diags := parseUUID(s.UUID)
+	uuid, diags := ParseUUID(s.UUID)
 	if diags.HasError() {
 		return artieclient.SSHTunnel{}, diags
 	}
@@ -11,7 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
 
-func parseUUID(value types.String) (uuid.UUID, diag.Diagnostics) {
+func ParseUUID(value types.String) (uuid.UUID, diag.Diagnostics) {
 	if value.ValueString() == "" {
 		return uuid.UUID{}, []diag.Diagnostic{diag.NewErrorDiagnostic("UUID is empty", "")}
 	}
@@ -24,12 +24,12 @@ func parseUUID(value types.String) (uuid.UUID, diag.Diagnostics) {
 	return u, nil
 }
 
-func parseOptionalUUID(value types.String) (*uuid.UUID, diag.Diagnostics) {
+func ParseOptionalUUID(value types.String) (*uuid.UUID, diag.Diagnostics) {
 	if value.ValueString() == "" {
 		return nil, nil
 	}
 
-	u, diags := parseUUID(value)
+	u, diags := ParseUUID(value)
 	if diags.HasError() {
 		return nil, diags
 	}
@@ -37,7 +37,7 @@ func parseOptionalUUID(value types.String) (*uuid.UUID, diag.Diagnostics) {
 	return &u, diags
 }
 
-func optionalUUIDToStringValue(value *uuid.UUID) types.String {
+func OptionalUUIDToStringValue(value *uuid.UUID) types.String {
 	if value == nil {
 		return types.StringValue("")
 	}
@@ -100,6 +100,15 @@ func boolPointerValueOrFalse(value *bool) types.Bool {
 	return types.BoolValue(*value)
 }
 
+// knownBoolPointer returns a pointer to value, or nil if it's null or unknown. Unlike ValueBoolPointer, this doesn't
+// turn a value that's only known after apply into false.
+func knownBoolPointer(value types.Bool) *bool {
+	if !IsKnown(value) {
+		return nil
+	}
+	return value.ValueBoolPointer()
+}
+
 // IsKnownAndEmpty returns true if the value is known (not null/unknown) and is an empty string.
 func IsKnownAndEmpty(value types.String) bool {
 	return !value.IsUnknown() && value.ValueString() == ""